@@ -24,13 +24,54 @@ const _ = grpc.SupportPackageIsVersion7
 type RealworldClient interface {
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*UserReply, error)
 	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*UserReply, error)
+	// 两步登录第二步, 使用 Login 返回的 mfa_token 与验证码或恢复码换取 token
+	LoginMFA(ctx context.Context, in *LoginMFARequest, opts ...grpc.CallOption) (*UserReply, error)
 	GetCurrentUser(ctx context.Context, in *GetCurrentUserRequest, opts ...grpc.CallOption) (*UserReply, error)
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UserReply, error)
+	// 使用 refresh token 换取新的 access token, 旧 refresh token 失效
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*UserReply, error)
+	// 退出登录, 吊销当前 access token 及传入的 refresh token
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutReply, error)
+	// 退出所有设备, 吊销该用户已签发的全部 token 与会话; 个人访问令牌不受影响, 仍可使用, 需单独吊销
+	LogoutAll(ctx context.Context, in *LogoutAllRequest, opts ...grpc.CallOption) (*LogoutReply, error)
+	// 重新发送邮箱验证邮件, 邮箱未注册或已验证时同样返回成功
+	RequestEmailVerification(ctx context.Context, in *RequestEmailVerificationRequest, opts ...grpc.CallOption) (*EmptyReply, error)
+	// 使用邮件中的 token 完成邮箱验证
+	ConfirmEmailVerification(ctx context.Context, in *ConfirmEmailVerificationRequest, opts ...grpc.CallOption) (*EmptyReply, error)
+	// 发送重置密码邮件, 邮箱未注册时同样返回成功
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*EmptyReply, error)
+	// 使用邮件中的 token 重置密码, 已签发的 token 与会话全部失效; 密码可能已泄露, 个人访问令牌一并删除
+	ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetRequest, opts ...grpc.CallOption) (*EmptyReply, error)
+	// 发起 OpenID Connect 登录, 返回提供方的授权地址; 已登录时回调后绑定到当前账号
+	StartOIDCLogin(ctx context.Context, in *StartOIDCLoginRequest, opts ...grpc.CallOption) (*StartOIDCLoginReply, error)
+	// OpenID Connect 回调, 提交提供方跳转时带回的 code 与 state; 绑定账号时需携带发起绑定的用户的 token
+	OIDCCallback(ctx context.Context, in *OIDCCallbackRequest, opts ...grpc.CallOption) (*UserReply, error)
+	// 当前用户绑定的第三方身份
+	ListIdentities(ctx context.Context, in *ListIdentitiesRequest, opts ...grpc.CallOption) (*ListIdentitiesReply, error)
+	// 解除第三方身份绑定, 不能解除唯一的登录方式
+	UnlinkIdentity(ctx context.Context, in *UnlinkIdentityRequest, opts ...grpc.CallOption) (*EmptyReply, error)
+	// 创建个人访问令牌, 明文令牌只在创建时返回; 退出所有设备后仍有效, 重置密码时删除
+	CreatePersonalToken(ctx context.Context, in *CreatePersonalTokenRequest, opts ...grpc.CallOption) (*PersonalTokenReply, error)
+	ListPersonalTokens(ctx context.Context, in *ListPersonalTokensRequest, opts ...grpc.CallOption) (*ListPersonalTokensReply, error)
+	RevokePersonalToken(ctx context.Context, in *RevokePersonalTokenRequest, opts ...grpc.CallOption) (*EmptyReply, error)
+	// 当前用户的登录会话(设备)
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsReply, error)
+	// 退出指定会话, 该设备的 token 立即失效
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*EmptyReply, error)
+	// 退出当前会话以外的全部会话
+	RevokeOtherSessions(ctx context.Context, in *RevokeOtherSessionsRequest, opts ...grpc.CallOption) (*EmptyReply, error)
+	// 生成 TOTP 密钥, 调用 ConfirmTOTP 校验验证码后生效
+	EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...grpc.CallOption) (*EnrollTOTPReply, error)
+	// 启用 TOTP, 返回恢复码(仅此一次)
+	ConfirmTOTP(ctx context.Context, in *ConfirmTOTPRequest, opts ...grpc.CallOption) (*RecoveryCodesReply, error)
+	// 关闭 TOTP, 需要密码及验证码或恢复码
+	DisableTOTP(ctx context.Context, in *DisableTOTPRequest, opts ...grpc.CallOption) (*EmptyReply, error)
 	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*ProfileReply, error)
 	FollowUser(ctx context.Context, in *FollowUserRequest, opts ...grpc.CallOption) (*ProfileReply, error)
 	UnfollowUser(ctx context.Context, in *UnfollowUserRequest, opts ...grpc.CallOption) (*ProfileReply, error)
 	ListArticles(ctx context.Context, in *ListArticlesRequest, opts ...grpc.CallOption) (*MultipleArticlesReply, error)
 	FeedArticles(ctx context.Context, in *FeedArticlesRequest, opts ...grpc.CallOption) (*MultipleArticlesReply, error)
+	// 按文章ID访问的路由位于 /api/articles/id/ 下, 与 slug 路由并存; "id" 因此不能作为 slug
 	GetArticle(ctx context.Context, in *GetArticleRequest, opts ...grpc.CallOption) (*SingleArticlesReply, error)
 	CreateArticle(ctx context.Context, in *CreateArticleRequest, opts ...grpc.CallOption) (*SingleArticlesReply, error)
 	UpdateArticle(ctx context.Context, in *UpdateArticleRequest, opts ...grpc.CallOption) (*SingleArticlesReply, error)
@@ -40,7 +81,28 @@ type RealworldClient interface {
 	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*SingleCommentReply, error)
 	FavoriteArticle(ctx context.Context, in *FavoriteArticleRequest, opts ...grpc.CallOption) (*SingleArticlesReply, error)
 	UnfavoriteArticle(ctx context.Context, in *UnfavoriteArticleRequest, opts ...grpc.CallOption) (*SingleArticlesReply, error)
+	// 恢复已删除的文章
+	RestoreArticle(ctx context.Context, in *RestoreArticleRequest, opts ...grpc.CallOption) (*SingleArticlesReply, error)
+	// 彻底删除文章, 包括已删除的文章
+	PurgeArticle(ctx context.Context, in *PurgeArticleRequest, opts ...grpc.CallOption) (*SingleArticlesReply, error)
+	// 恢复已删除的评论
+	RestoreComment(ctx context.Context, in *RestoreCommentRequest, opts ...grpc.CallOption) (*SingleCommentReply, error)
+	// 彻底删除评论, 包括已删除的评论
+	PurgeComment(ctx context.Context, in *PurgeCommentRequest, opts ...grpc.CallOption) (*SingleCommentReply, error)
 	GetTags(ctx context.Context, in *GetTagsRequest, opts ...grpc.CallOption) (*ListTagsReply, error)
+	// slug 路由, 兼容 RealWorld 规范; slug 为纯数字时按文章ID访问, 旧 slug 重定向到当前 slug
+	GetArticleBySlug(ctx context.Context, in *GetArticleBySlugRequest, opts ...grpc.CallOption) (*SingleArticlesReply, error)
+	UpdateArticleBySlug(ctx context.Context, in *UpdateArticleBySlugRequest, opts ...grpc.CallOption) (*SingleArticlesReply, error)
+	DeleteArticleBySlug(ctx context.Context, in *DeleteArticleBySlugRequest, opts ...grpc.CallOption) (*SingleArticlesReply, error)
+	AddCommentsBySlug(ctx context.Context, in *AddCommentsBySlugRequest, opts ...grpc.CallOption) (*SingleCommentReply, error)
+	GetCommentsBySlug(ctx context.Context, in *GetCommentsBySlugRequest, opts ...grpc.CallOption) (*MultipleCommentsReply, error)
+	DeleteCommentBySlug(ctx context.Context, in *DeleteCommentBySlugRequest, opts ...grpc.CallOption) (*SingleCommentReply, error)
+	FavoriteArticleBySlug(ctx context.Context, in *FavoriteArticleBySlugRequest, opts ...grpc.CallOption) (*SingleArticlesReply, error)
+	UnfavoriteArticleBySlug(ctx context.Context, in *UnfavoriteArticleBySlugRequest, opts ...grpc.CallOption) (*SingleArticlesReply, error)
+	// 解除账号的登录失败锁定, 并清除该账号失败来源 IP 的计数与锁定, 仅管理员可用. 账号被锁定后用户无法自助解除, 由管理员调用此接口恢复
+	UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...grpc.CallOption) (*EmptyReply, error)
+	// 修改用户角色, 仅管理员可用; 该用户已签发的 token 随即失效
+	SetUserRole(ctx context.Context, in *SetUserRoleRequest, opts ...grpc.CallOption) (*UserRoleReply, error)
 }
 
 type realworldClient struct {
@@ -69,6 +131,15 @@ func (c *realworldClient) Register(ctx context.Context, in *RegisterRequest, opt
 	return out, nil
 }
 
+func (c *realworldClient) LoginMFA(ctx context.Context, in *LoginMFARequest, opts ...grpc.CallOption) (*UserReply, error) {
+	out := new(UserReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/LoginMFA", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *realworldClient) GetCurrentUser(ctx context.Context, in *GetCurrentUserRequest, opts ...grpc.CallOption) (*UserReply, error) {
 	out := new(UserReply)
 	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/GetCurrentUser", in, out, opts...)
@@ -87,6 +158,186 @@ func (c *realworldClient) UpdateUser(ctx context.Context, in *UpdateUserRequest,
 	return out, nil
 }
 
+func (c *realworldClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*UserReply, error) {
+	out := new(UserReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/RefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutReply, error) {
+	out := new(LogoutReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/Logout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) LogoutAll(ctx context.Context, in *LogoutAllRequest, opts ...grpc.CallOption) (*LogoutReply, error) {
+	out := new(LogoutReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/LogoutAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) RequestEmailVerification(ctx context.Context, in *RequestEmailVerificationRequest, opts ...grpc.CallOption) (*EmptyReply, error) {
+	out := new(EmptyReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/RequestEmailVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) ConfirmEmailVerification(ctx context.Context, in *ConfirmEmailVerificationRequest, opts ...grpc.CallOption) (*EmptyReply, error) {
+	out := new(EmptyReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/ConfirmEmailVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*EmptyReply, error) {
+	out := new(EmptyReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/RequestPasswordReset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetRequest, opts ...grpc.CallOption) (*EmptyReply, error) {
+	out := new(EmptyReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/ConfirmPasswordReset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) StartOIDCLogin(ctx context.Context, in *StartOIDCLoginRequest, opts ...grpc.CallOption) (*StartOIDCLoginReply, error) {
+	out := new(StartOIDCLoginReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/StartOIDCLogin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) OIDCCallback(ctx context.Context, in *OIDCCallbackRequest, opts ...grpc.CallOption) (*UserReply, error) {
+	out := new(UserReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/OIDCCallback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) ListIdentities(ctx context.Context, in *ListIdentitiesRequest, opts ...grpc.CallOption) (*ListIdentitiesReply, error) {
+	out := new(ListIdentitiesReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/ListIdentities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) UnlinkIdentity(ctx context.Context, in *UnlinkIdentityRequest, opts ...grpc.CallOption) (*EmptyReply, error) {
+	out := new(EmptyReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/UnlinkIdentity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) CreatePersonalToken(ctx context.Context, in *CreatePersonalTokenRequest, opts ...grpc.CallOption) (*PersonalTokenReply, error) {
+	out := new(PersonalTokenReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/CreatePersonalToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) ListPersonalTokens(ctx context.Context, in *ListPersonalTokensRequest, opts ...grpc.CallOption) (*ListPersonalTokensReply, error) {
+	out := new(ListPersonalTokensReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/ListPersonalTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) RevokePersonalToken(ctx context.Context, in *RevokePersonalTokenRequest, opts ...grpc.CallOption) (*EmptyReply, error) {
+	out := new(EmptyReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/RevokePersonalToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsReply, error) {
+	out := new(ListSessionsReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*EmptyReply, error) {
+	out := new(EmptyReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/RevokeSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) RevokeOtherSessions(ctx context.Context, in *RevokeOtherSessionsRequest, opts ...grpc.CallOption) (*EmptyReply, error) {
+	out := new(EmptyReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/RevokeOtherSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...grpc.CallOption) (*EnrollTOTPReply, error) {
+	out := new(EnrollTOTPReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/EnrollTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) ConfirmTOTP(ctx context.Context, in *ConfirmTOTPRequest, opts ...grpc.CallOption) (*RecoveryCodesReply, error) {
+	out := new(RecoveryCodesReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/ConfirmTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) DisableTOTP(ctx context.Context, in *DisableTOTPRequest, opts ...grpc.CallOption) (*EmptyReply, error) {
+	out := new(EmptyReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/DisableTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *realworldClient) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*ProfileReply, error) {
 	out := new(ProfileReply)
 	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/GetProfile", in, out, opts...)
@@ -213,6 +464,42 @@ func (c *realworldClient) UnfavoriteArticle(ctx context.Context, in *UnfavoriteA
 	return out, nil
 }
 
+func (c *realworldClient) RestoreArticle(ctx context.Context, in *RestoreArticleRequest, opts ...grpc.CallOption) (*SingleArticlesReply, error) {
+	out := new(SingleArticlesReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/RestoreArticle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) PurgeArticle(ctx context.Context, in *PurgeArticleRequest, opts ...grpc.CallOption) (*SingleArticlesReply, error) {
+	out := new(SingleArticlesReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/PurgeArticle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) RestoreComment(ctx context.Context, in *RestoreCommentRequest, opts ...grpc.CallOption) (*SingleCommentReply, error) {
+	out := new(SingleCommentReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/RestoreComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) PurgeComment(ctx context.Context, in *PurgeCommentRequest, opts ...grpc.CallOption) (*SingleCommentReply, error) {
+	out := new(SingleCommentReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/PurgeComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *realworldClient) GetTags(ctx context.Context, in *GetTagsRequest, opts ...grpc.CallOption) (*ListTagsReply, error) {
 	out := new(ListTagsReply)
 	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/GetTags", in, out, opts...)
@@ -222,19 +509,150 @@ func (c *realworldClient) GetTags(ctx context.Context, in *GetTagsRequest, opts
 	return out, nil
 }
 
+func (c *realworldClient) GetArticleBySlug(ctx context.Context, in *GetArticleBySlugRequest, opts ...grpc.CallOption) (*SingleArticlesReply, error) {
+	out := new(SingleArticlesReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/GetArticleBySlug", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) UpdateArticleBySlug(ctx context.Context, in *UpdateArticleBySlugRequest, opts ...grpc.CallOption) (*SingleArticlesReply, error) {
+	out := new(SingleArticlesReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/UpdateArticleBySlug", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) DeleteArticleBySlug(ctx context.Context, in *DeleteArticleBySlugRequest, opts ...grpc.CallOption) (*SingleArticlesReply, error) {
+	out := new(SingleArticlesReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/DeleteArticleBySlug", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) AddCommentsBySlug(ctx context.Context, in *AddCommentsBySlugRequest, opts ...grpc.CallOption) (*SingleCommentReply, error) {
+	out := new(SingleCommentReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/AddCommentsBySlug", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) GetCommentsBySlug(ctx context.Context, in *GetCommentsBySlugRequest, opts ...grpc.CallOption) (*MultipleCommentsReply, error) {
+	out := new(MultipleCommentsReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/GetCommentsBySlug", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) DeleteCommentBySlug(ctx context.Context, in *DeleteCommentBySlugRequest, opts ...grpc.CallOption) (*SingleCommentReply, error) {
+	out := new(SingleCommentReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/DeleteCommentBySlug", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) FavoriteArticleBySlug(ctx context.Context, in *FavoriteArticleBySlugRequest, opts ...grpc.CallOption) (*SingleArticlesReply, error) {
+	out := new(SingleArticlesReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/FavoriteArticleBySlug", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) UnfavoriteArticleBySlug(ctx context.Context, in *UnfavoriteArticleBySlugRequest, opts ...grpc.CallOption) (*SingleArticlesReply, error) {
+	out := new(SingleArticlesReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/UnfavoriteArticleBySlug", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...grpc.CallOption) (*EmptyReply, error) {
+	out := new(EmptyReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/UnlockUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realworldClient) SetUserRole(ctx context.Context, in *SetUserRoleRequest, opts ...grpc.CallOption) (*UserRoleReply, error) {
+	out := new(UserRoleReply)
+	err := c.cc.Invoke(ctx, "/realworld.v1.Realworld/SetUserRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RealworldServer is the server API for Realworld service.
 // All implementations must embed UnimplementedRealworldServer
 // for forward compatibility
 type RealworldServer interface {
 	Login(context.Context, *LoginRequest) (*UserReply, error)
 	Register(context.Context, *RegisterRequest) (*UserReply, error)
+	// 两步登录第二步, 使用 Login 返回的 mfa_token 与验证码或恢复码换取 token
+	LoginMFA(context.Context, *LoginMFARequest) (*UserReply, error)
 	GetCurrentUser(context.Context, *GetCurrentUserRequest) (*UserReply, error)
 	UpdateUser(context.Context, *UpdateUserRequest) (*UserReply, error)
+	// 使用 refresh token 换取新的 access token, 旧 refresh token 失效
+	RefreshToken(context.Context, *RefreshTokenRequest) (*UserReply, error)
+	// 退出登录, 吊销当前 access token 及传入的 refresh token
+	Logout(context.Context, *LogoutRequest) (*LogoutReply, error)
+	// 退出所有设备, 吊销该用户已签发的全部 token 与会话; 个人访问令牌不受影响, 仍可使用, 需单独吊销
+	LogoutAll(context.Context, *LogoutAllRequest) (*LogoutReply, error)
+	// 重新发送邮箱验证邮件, 邮箱未注册或已验证时同样返回成功
+	RequestEmailVerification(context.Context, *RequestEmailVerificationRequest) (*EmptyReply, error)
+	// 使用邮件中的 token 完成邮箱验证
+	ConfirmEmailVerification(context.Context, *ConfirmEmailVerificationRequest) (*EmptyReply, error)
+	// 发送重置密码邮件, 邮箱未注册时同样返回成功
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*EmptyReply, error)
+	// 使用邮件中的 token 重置密码, 已签发的 token 与会话全部失效; 密码可能已泄露, 个人访问令牌一并删除
+	ConfirmPasswordReset(context.Context, *ConfirmPasswordResetRequest) (*EmptyReply, error)
+	// 发起 OpenID Connect 登录, 返回提供方的授权地址; 已登录时回调后绑定到当前账号
+	StartOIDCLogin(context.Context, *StartOIDCLoginRequest) (*StartOIDCLoginReply, error)
+	// OpenID Connect 回调, 提交提供方跳转时带回的 code 与 state; 绑定账号时需携带发起绑定的用户的 token
+	OIDCCallback(context.Context, *OIDCCallbackRequest) (*UserReply, error)
+	// 当前用户绑定的第三方身份
+	ListIdentities(context.Context, *ListIdentitiesRequest) (*ListIdentitiesReply, error)
+	// 解除第三方身份绑定, 不能解除唯一的登录方式
+	UnlinkIdentity(context.Context, *UnlinkIdentityRequest) (*EmptyReply, error)
+	// 创建个人访问令牌, 明文令牌只在创建时返回; 退出所有设备后仍有效, 重置密码时删除
+	CreatePersonalToken(context.Context, *CreatePersonalTokenRequest) (*PersonalTokenReply, error)
+	ListPersonalTokens(context.Context, *ListPersonalTokensRequest) (*ListPersonalTokensReply, error)
+	RevokePersonalToken(context.Context, *RevokePersonalTokenRequest) (*EmptyReply, error)
+	// 当前用户的登录会话(设备)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsReply, error)
+	// 退出指定会话, 该设备的 token 立即失效
+	RevokeSession(context.Context, *RevokeSessionRequest) (*EmptyReply, error)
+	// 退出当前会话以外的全部会话
+	RevokeOtherSessions(context.Context, *RevokeOtherSessionsRequest) (*EmptyReply, error)
+	// 生成 TOTP 密钥, 调用 ConfirmTOTP 校验验证码后生效
+	EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPReply, error)
+	// 启用 TOTP, 返回恢复码(仅此一次)
+	ConfirmTOTP(context.Context, *ConfirmTOTPRequest) (*RecoveryCodesReply, error)
+	// 关闭 TOTP, 需要密码及验证码或恢复码
+	DisableTOTP(context.Context, *DisableTOTPRequest) (*EmptyReply, error)
 	GetProfile(context.Context, *GetProfileRequest) (*ProfileReply, error)
 	FollowUser(context.Context, *FollowUserRequest) (*ProfileReply, error)
 	UnfollowUser(context.Context, *UnfollowUserRequest) (*ProfileReply, error)
 	ListArticles(context.Context, *ListArticlesRequest) (*MultipleArticlesReply, error)
 	FeedArticles(context.Context, *FeedArticlesRequest) (*MultipleArticlesReply, error)
+	// 按文章ID访问的路由位于 /api/articles/id/ 下, 与 slug 路由并存; "id" 因此不能作为 slug
 	GetArticle(context.Context, *GetArticleRequest) (*SingleArticlesReply, error)
 	CreateArticle(context.Context, *CreateArticleRequest) (*SingleArticlesReply, error)
 	UpdateArticle(context.Context, *UpdateArticleRequest) (*SingleArticlesReply, error)
@@ -244,7 +662,28 @@ type RealworldServer interface {
 	DeleteComment(context.Context, *DeleteCommentRequest) (*SingleCommentReply, error)
 	FavoriteArticle(context.Context, *FavoriteArticleRequest) (*SingleArticlesReply, error)
 	UnfavoriteArticle(context.Context, *UnfavoriteArticleRequest) (*SingleArticlesReply, error)
+	// 恢复已删除的文章
+	RestoreArticle(context.Context, *RestoreArticleRequest) (*SingleArticlesReply, error)
+	// 彻底删除文章, 包括已删除的文章
+	PurgeArticle(context.Context, *PurgeArticleRequest) (*SingleArticlesReply, error)
+	// 恢复已删除的评论
+	RestoreComment(context.Context, *RestoreCommentRequest) (*SingleCommentReply, error)
+	// 彻底删除评论, 包括已删除的评论
+	PurgeComment(context.Context, *PurgeCommentRequest) (*SingleCommentReply, error)
 	GetTags(context.Context, *GetTagsRequest) (*ListTagsReply, error)
+	// slug 路由, 兼容 RealWorld 规范; slug 为纯数字时按文章ID访问, 旧 slug 重定向到当前 slug
+	GetArticleBySlug(context.Context, *GetArticleBySlugRequest) (*SingleArticlesReply, error)
+	UpdateArticleBySlug(context.Context, *UpdateArticleBySlugRequest) (*SingleArticlesReply, error)
+	DeleteArticleBySlug(context.Context, *DeleteArticleBySlugRequest) (*SingleArticlesReply, error)
+	AddCommentsBySlug(context.Context, *AddCommentsBySlugRequest) (*SingleCommentReply, error)
+	GetCommentsBySlug(context.Context, *GetCommentsBySlugRequest) (*MultipleCommentsReply, error)
+	DeleteCommentBySlug(context.Context, *DeleteCommentBySlugRequest) (*SingleCommentReply, error)
+	FavoriteArticleBySlug(context.Context, *FavoriteArticleBySlugRequest) (*SingleArticlesReply, error)
+	UnfavoriteArticleBySlug(context.Context, *UnfavoriteArticleBySlugRequest) (*SingleArticlesReply, error)
+	// 解除账号的登录失败锁定, 并清除该账号失败来源 IP 的计数与锁定, 仅管理员可用. 账号被锁定后用户无法自助解除, 由管理员调用此接口恢复
+	UnlockUser(context.Context, *UnlockUserRequest) (*EmptyReply, error)
+	// 修改用户角色, 仅管理员可用; 该用户已签发的 token 随即失效
+	SetUserRole(context.Context, *SetUserRoleRequest) (*UserRoleReply, error)
 	mustEmbedUnimplementedRealworldServer()
 }
 
@@ -258,12 +697,75 @@ func (UnimplementedRealworldServer) Login(context.Context, *LoginRequest) (*User
 func (UnimplementedRealworldServer) Register(context.Context, *RegisterRequest) (*UserReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
 }
+func (UnimplementedRealworldServer) LoginMFA(context.Context, *LoginMFARequest) (*UserReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginMFA not implemented")
+}
 func (UnimplementedRealworldServer) GetCurrentUser(context.Context, *GetCurrentUserRequest) (*UserReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentUser not implemented")
 }
 func (UnimplementedRealworldServer) UpdateUser(context.Context, *UpdateUserRequest) (*UserReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
 }
+func (UnimplementedRealworldServer) RefreshToken(context.Context, *RefreshTokenRequest) (*UserReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedRealworldServer) Logout(context.Context, *LogoutRequest) (*LogoutReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedRealworldServer) LogoutAll(context.Context, *LogoutAllRequest) (*LogoutReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogoutAll not implemented")
+}
+func (UnimplementedRealworldServer) RequestEmailVerification(context.Context, *RequestEmailVerificationRequest) (*EmptyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestEmailVerification not implemented")
+}
+func (UnimplementedRealworldServer) ConfirmEmailVerification(context.Context, *ConfirmEmailVerificationRequest) (*EmptyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmEmailVerification not implemented")
+}
+func (UnimplementedRealworldServer) RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*EmptyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedRealworldServer) ConfirmPasswordReset(context.Context, *ConfirmPasswordResetRequest) (*EmptyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmPasswordReset not implemented")
+}
+func (UnimplementedRealworldServer) StartOIDCLogin(context.Context, *StartOIDCLoginRequest) (*StartOIDCLoginReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartOIDCLogin not implemented")
+}
+func (UnimplementedRealworldServer) OIDCCallback(context.Context, *OIDCCallbackRequest) (*UserReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OIDCCallback not implemented")
+}
+func (UnimplementedRealworldServer) ListIdentities(context.Context, *ListIdentitiesRequest) (*ListIdentitiesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIdentities not implemented")
+}
+func (UnimplementedRealworldServer) UnlinkIdentity(context.Context, *UnlinkIdentityRequest) (*EmptyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkIdentity not implemented")
+}
+func (UnimplementedRealworldServer) CreatePersonalToken(context.Context, *CreatePersonalTokenRequest) (*PersonalTokenReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePersonalToken not implemented")
+}
+func (UnimplementedRealworldServer) ListPersonalTokens(context.Context, *ListPersonalTokensRequest) (*ListPersonalTokensReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPersonalTokens not implemented")
+}
+func (UnimplementedRealworldServer) RevokePersonalToken(context.Context, *RevokePersonalTokenRequest) (*EmptyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePersonalToken not implemented")
+}
+func (UnimplementedRealworldServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedRealworldServer) RevokeSession(context.Context, *RevokeSessionRequest) (*EmptyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedRealworldServer) RevokeOtherSessions(context.Context, *RevokeOtherSessionsRequest) (*EmptyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeOtherSessions not implemented")
+}
+func (UnimplementedRealworldServer) EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTOTP not implemented")
+}
+func (UnimplementedRealworldServer) ConfirmTOTP(context.Context, *ConfirmTOTPRequest) (*RecoveryCodesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTOTP not implemented")
+}
+func (UnimplementedRealworldServer) DisableTOTP(context.Context, *DisableTOTPRequest) (*EmptyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTOTP not implemented")
+}
 func (UnimplementedRealworldServer) GetProfile(context.Context, *GetProfileRequest) (*ProfileReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
 }
@@ -306,15 +808,57 @@ func (UnimplementedRealworldServer) FavoriteArticle(context.Context, *FavoriteAr
 func (UnimplementedRealworldServer) UnfavoriteArticle(context.Context, *UnfavoriteArticleRequest) (*SingleArticlesReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnfavoriteArticle not implemented")
 }
+func (UnimplementedRealworldServer) RestoreArticle(context.Context, *RestoreArticleRequest) (*SingleArticlesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreArticle not implemented")
+}
+func (UnimplementedRealworldServer) PurgeArticle(context.Context, *PurgeArticleRequest) (*SingleArticlesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeArticle not implemented")
+}
+func (UnimplementedRealworldServer) RestoreComment(context.Context, *RestoreCommentRequest) (*SingleCommentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreComment not implemented")
+}
+func (UnimplementedRealworldServer) PurgeComment(context.Context, *PurgeCommentRequest) (*SingleCommentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeComment not implemented")
+}
 func (UnimplementedRealworldServer) GetTags(context.Context, *GetTagsRequest) (*ListTagsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTags not implemented")
 }
-func (UnimplementedRealworldServer) mustEmbedUnimplementedRealworldServer() {}
-
-// UnsafeRealworldServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to RealworldServer will
-// result in compilation errors.
-type UnsafeRealworldServer interface {
+func (UnimplementedRealworldServer) GetArticleBySlug(context.Context, *GetArticleBySlugRequest) (*SingleArticlesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArticleBySlug not implemented")
+}
+func (UnimplementedRealworldServer) UpdateArticleBySlug(context.Context, *UpdateArticleBySlugRequest) (*SingleArticlesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateArticleBySlug not implemented")
+}
+func (UnimplementedRealworldServer) DeleteArticleBySlug(context.Context, *DeleteArticleBySlugRequest) (*SingleArticlesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteArticleBySlug not implemented")
+}
+func (UnimplementedRealworldServer) AddCommentsBySlug(context.Context, *AddCommentsBySlugRequest) (*SingleCommentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCommentsBySlug not implemented")
+}
+func (UnimplementedRealworldServer) GetCommentsBySlug(context.Context, *GetCommentsBySlugRequest) (*MultipleCommentsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentsBySlug not implemented")
+}
+func (UnimplementedRealworldServer) DeleteCommentBySlug(context.Context, *DeleteCommentBySlugRequest) (*SingleCommentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCommentBySlug not implemented")
+}
+func (UnimplementedRealworldServer) FavoriteArticleBySlug(context.Context, *FavoriteArticleBySlugRequest) (*SingleArticlesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FavoriteArticleBySlug not implemented")
+}
+func (UnimplementedRealworldServer) UnfavoriteArticleBySlug(context.Context, *UnfavoriteArticleBySlugRequest) (*SingleArticlesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfavoriteArticleBySlug not implemented")
+}
+func (UnimplementedRealworldServer) UnlockUser(context.Context, *UnlockUserRequest) (*EmptyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockUser not implemented")
+}
+func (UnimplementedRealworldServer) SetUserRole(context.Context, *SetUserRoleRequest) (*UserRoleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserRole not implemented")
+}
+func (UnimplementedRealworldServer) mustEmbedUnimplementedRealworldServer() {}
+
+// UnsafeRealworldServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RealworldServer will
+// result in compilation errors.
+type UnsafeRealworldServer interface {
 	mustEmbedUnimplementedRealworldServer()
 }
 
@@ -358,6 +902,24 @@ func _Realworld_Register_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Realworld_LoginMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginMFARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).LoginMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/LoginMFA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).LoginMFA(ctx, req.(*LoginMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Realworld_GetCurrentUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCurrentUserRequest)
 	if err := dec(in); err != nil {
@@ -382,14 +944,374 @@ func _Realworld_UpdateUser_Handler(srv interface{}, ctx context.Context, dec fun
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealworldServer).UpdateUser(ctx, in)
+		return srv.(RealworldServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/UpdateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/RefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/Logout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_LogoutAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).LogoutAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/LogoutAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).LogoutAll(ctx, req.(*LogoutAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_RequestEmailVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestEmailVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).RequestEmailVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/RequestEmailVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).RequestEmailVerification(ctx, req.(*RequestEmailVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_ConfirmEmailVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmEmailVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).ConfirmEmailVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/ConfirmEmailVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).ConfirmEmailVerification(ctx, req.(*ConfirmEmailVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/RequestPasswordReset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_ConfirmPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).ConfirmPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/ConfirmPasswordReset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).ConfirmPasswordReset(ctx, req.(*ConfirmPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_StartOIDCLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartOIDCLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).StartOIDCLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/StartOIDCLogin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).StartOIDCLogin(ctx, req.(*StartOIDCLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_OIDCCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OIDCCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).OIDCCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/OIDCCallback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).OIDCCallback(ctx, req.(*OIDCCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_ListIdentities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIdentitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).ListIdentities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/ListIdentities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).ListIdentities(ctx, req.(*ListIdentitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_UnlinkIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).UnlinkIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/UnlinkIdentity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).UnlinkIdentity(ctx, req.(*UnlinkIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_CreatePersonalToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePersonalTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).CreatePersonalToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/CreatePersonalToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).CreatePersonalToken(ctx, req.(*CreatePersonalTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_ListPersonalTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPersonalTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).ListPersonalTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/ListPersonalTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).ListPersonalTokens(ctx, req.(*ListPersonalTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_RevokePersonalToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokePersonalTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).RevokePersonalToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/RevokePersonalToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).RevokePersonalToken(ctx, req.(*RevokePersonalTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/RevokeSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_RevokeOtherSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeOtherSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).RevokeOtherSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/RevokeOtherSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).RevokeOtherSessions(ctx, req.(*RevokeOtherSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_EnrollTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).EnrollTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/EnrollTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).EnrollTOTP(ctx, req.(*EnrollTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_ConfirmTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).ConfirmTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/ConfirmTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).ConfirmTOTP(ctx, req.(*ConfirmTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_DisableTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).DisableTOTP(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/realworld.v1.Realworld/UpdateUser",
+		FullMethod: "/realworld.v1.Realworld/DisableTOTP",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealworldServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+		return srv.(RealworldServer).DisableTOTP(ctx, req.(*DisableTOTPRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -646,6 +1568,78 @@ func _Realworld_UnfavoriteArticle_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Realworld_RestoreArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).RestoreArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/RestoreArticle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).RestoreArticle(ctx, req.(*RestoreArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_PurgeArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).PurgeArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/PurgeArticle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).PurgeArticle(ctx, req.(*PurgeArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_RestoreComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).RestoreComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/RestoreComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).RestoreComment(ctx, req.(*RestoreCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_PurgeComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).PurgeComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/PurgeComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).PurgeComment(ctx, req.(*PurgeCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Realworld_GetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTagsRequest)
 	if err := dec(in); err != nil {
@@ -664,6 +1658,186 @@ func _Realworld_GetTags_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Realworld_GetArticleBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArticleBySlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).GetArticleBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/GetArticleBySlug",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).GetArticleBySlug(ctx, req.(*GetArticleBySlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_UpdateArticleBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateArticleBySlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).UpdateArticleBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/UpdateArticleBySlug",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).UpdateArticleBySlug(ctx, req.(*UpdateArticleBySlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_DeleteArticleBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteArticleBySlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).DeleteArticleBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/DeleteArticleBySlug",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).DeleteArticleBySlug(ctx, req.(*DeleteArticleBySlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_AddCommentsBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCommentsBySlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).AddCommentsBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/AddCommentsBySlug",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).AddCommentsBySlug(ctx, req.(*AddCommentsBySlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_GetCommentsBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentsBySlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).GetCommentsBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/GetCommentsBySlug",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).GetCommentsBySlug(ctx, req.(*GetCommentsBySlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_DeleteCommentBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentBySlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).DeleteCommentBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/DeleteCommentBySlug",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).DeleteCommentBySlug(ctx, req.(*DeleteCommentBySlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_FavoriteArticleBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteArticleBySlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).FavoriteArticleBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/FavoriteArticleBySlug",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).FavoriteArticleBySlug(ctx, req.(*FavoriteArticleBySlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_UnfavoriteArticleBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfavoriteArticleBySlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).UnfavoriteArticleBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/UnfavoriteArticleBySlug",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).UnfavoriteArticleBySlug(ctx, req.(*UnfavoriteArticleBySlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_UnlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).UnlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/UnlockUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).UnlockUser(ctx, req.(*UnlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Realworld_SetUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealworldServer).SetUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realworld.v1.Realworld/SetUserRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealworldServer).SetUserRole(ctx, req.(*SetUserRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Realworld_ServiceDesc is the grpc.ServiceDesc for Realworld service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -679,6 +1853,10 @@ var Realworld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Register",
 			Handler:    _Realworld_Register_Handler,
 		},
+		{
+			MethodName: "LoginMFA",
+			Handler:    _Realworld_LoginMFA_Handler,
+		},
 		{
 			MethodName: "GetCurrentUser",
 			Handler:    _Realworld_GetCurrentUser_Handler,
@@ -687,6 +1865,86 @@ var Realworld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateUser",
 			Handler:    _Realworld_UpdateUser_Handler,
 		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _Realworld_RefreshToken_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _Realworld_Logout_Handler,
+		},
+		{
+			MethodName: "LogoutAll",
+			Handler:    _Realworld_LogoutAll_Handler,
+		},
+		{
+			MethodName: "RequestEmailVerification",
+			Handler:    _Realworld_RequestEmailVerification_Handler,
+		},
+		{
+			MethodName: "ConfirmEmailVerification",
+			Handler:    _Realworld_ConfirmEmailVerification_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _Realworld_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ConfirmPasswordReset",
+			Handler:    _Realworld_ConfirmPasswordReset_Handler,
+		},
+		{
+			MethodName: "StartOIDCLogin",
+			Handler:    _Realworld_StartOIDCLogin_Handler,
+		},
+		{
+			MethodName: "OIDCCallback",
+			Handler:    _Realworld_OIDCCallback_Handler,
+		},
+		{
+			MethodName: "ListIdentities",
+			Handler:    _Realworld_ListIdentities_Handler,
+		},
+		{
+			MethodName: "UnlinkIdentity",
+			Handler:    _Realworld_UnlinkIdentity_Handler,
+		},
+		{
+			MethodName: "CreatePersonalToken",
+			Handler:    _Realworld_CreatePersonalToken_Handler,
+		},
+		{
+			MethodName: "ListPersonalTokens",
+			Handler:    _Realworld_ListPersonalTokens_Handler,
+		},
+		{
+			MethodName: "RevokePersonalToken",
+			Handler:    _Realworld_RevokePersonalToken_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _Realworld_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _Realworld_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeOtherSessions",
+			Handler:    _Realworld_RevokeOtherSessions_Handler,
+		},
+		{
+			MethodName: "EnrollTOTP",
+			Handler:    _Realworld_EnrollTOTP_Handler,
+		},
+		{
+			MethodName: "ConfirmTOTP",
+			Handler:    _Realworld_ConfirmTOTP_Handler,
+		},
+		{
+			MethodName: "DisableTOTP",
+			Handler:    _Realworld_DisableTOTP_Handler,
+		},
 		{
 			MethodName: "GetProfile",
 			Handler:    _Realworld_GetProfile_Handler,
@@ -743,10 +2001,66 @@ var Realworld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnfavoriteArticle",
 			Handler:    _Realworld_UnfavoriteArticle_Handler,
 		},
+		{
+			MethodName: "RestoreArticle",
+			Handler:    _Realworld_RestoreArticle_Handler,
+		},
+		{
+			MethodName: "PurgeArticle",
+			Handler:    _Realworld_PurgeArticle_Handler,
+		},
+		{
+			MethodName: "RestoreComment",
+			Handler:    _Realworld_RestoreComment_Handler,
+		},
+		{
+			MethodName: "PurgeComment",
+			Handler:    _Realworld_PurgeComment_Handler,
+		},
 		{
 			MethodName: "GetTags",
 			Handler:    _Realworld_GetTags_Handler,
 		},
+		{
+			MethodName: "GetArticleBySlug",
+			Handler:    _Realworld_GetArticleBySlug_Handler,
+		},
+		{
+			MethodName: "UpdateArticleBySlug",
+			Handler:    _Realworld_UpdateArticleBySlug_Handler,
+		},
+		{
+			MethodName: "DeleteArticleBySlug",
+			Handler:    _Realworld_DeleteArticleBySlug_Handler,
+		},
+		{
+			MethodName: "AddCommentsBySlug",
+			Handler:    _Realworld_AddCommentsBySlug_Handler,
+		},
+		{
+			MethodName: "GetCommentsBySlug",
+			Handler:    _Realworld_GetCommentsBySlug_Handler,
+		},
+		{
+			MethodName: "DeleteCommentBySlug",
+			Handler:    _Realworld_DeleteCommentBySlug_Handler,
+		},
+		{
+			MethodName: "FavoriteArticleBySlug",
+			Handler:    _Realworld_FavoriteArticleBySlug_Handler,
+		},
+		{
+			MethodName: "UnfavoriteArticleBySlug",
+			Handler:    _Realworld_UnfavoriteArticleBySlug_Handler,
+		},
+		{
+			MethodName: "UnlockUser",
+			Handler:    _Realworld_UnlockUser_Handler,
+		},
+		{
+			MethodName: "SetUserRole",
+			Handler:    _Realworld_SetUserRole_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/realworld/v1/realworld.proto",
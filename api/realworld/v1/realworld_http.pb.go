@@ -19,23 +19,58 @@ const _ = http.SupportPackageIsVersion1
 
 type RealworldHTTPServer interface {
 	AddComments(context.Context, *AddCommentsRequest) (*SingleCommentReply, error)
+	AddCommentsBySlug(context.Context, *AddCommentsBySlugRequest) (*SingleCommentReply, error)
+	ConfirmEmailVerification(context.Context, *ConfirmEmailVerificationRequest) (*EmptyReply, error)
+	ConfirmPasswordReset(context.Context, *ConfirmPasswordResetRequest) (*EmptyReply, error)
+	ConfirmTOTP(context.Context, *ConfirmTOTPRequest) (*RecoveryCodesReply, error)
 	CreateArticle(context.Context, *CreateArticleRequest) (*SingleArticlesReply, error)
+	CreatePersonalToken(context.Context, *CreatePersonalTokenRequest) (*PersonalTokenReply, error)
 	DeleteArticle(context.Context, *DeleteArticleRequest) (*SingleArticlesReply, error)
+	DeleteArticleBySlug(context.Context, *DeleteArticleBySlugRequest) (*SingleArticlesReply, error)
 	DeleteComment(context.Context, *DeleteCommentRequest) (*SingleCommentReply, error)
+	DeleteCommentBySlug(context.Context, *DeleteCommentBySlugRequest) (*SingleCommentReply, error)
+	DisableTOTP(context.Context, *DisableTOTPRequest) (*EmptyReply, error)
+	EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPReply, error)
 	FavoriteArticle(context.Context, *FavoriteArticleRequest) (*SingleArticlesReply, error)
+	FavoriteArticleBySlug(context.Context, *FavoriteArticleBySlugRequest) (*SingleArticlesReply, error)
 	FeedArticles(context.Context, *FeedArticlesRequest) (*MultipleArticlesReply, error)
 	FollowUser(context.Context, *FollowUserRequest) (*ProfileReply, error)
 	GetArticle(context.Context, *GetArticleRequest) (*SingleArticlesReply, error)
+	GetArticleBySlug(context.Context, *GetArticleBySlugRequest) (*SingleArticlesReply, error)
 	GetComments(context.Context, *GetCommentsRequest) (*MultipleCommentsReply, error)
+	GetCommentsBySlug(context.Context, *GetCommentsBySlugRequest) (*MultipleCommentsReply, error)
 	GetCurrentUser(context.Context, *GetCurrentUserRequest) (*UserReply, error)
 	GetProfile(context.Context, *GetProfileRequest) (*ProfileReply, error)
 	GetTags(context.Context, *GetTagsRequest) (*ListTagsReply, error)
 	ListArticles(context.Context, *ListArticlesRequest) (*MultipleArticlesReply, error)
+	ListIdentities(context.Context, *ListIdentitiesRequest) (*ListIdentitiesReply, error)
+	ListPersonalTokens(context.Context, *ListPersonalTokensRequest) (*ListPersonalTokensReply, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsReply, error)
 	Login(context.Context, *LoginRequest) (*UserReply, error)
+	LoginMFA(context.Context, *LoginMFARequest) (*UserReply, error)
+	Logout(context.Context, *LogoutRequest) (*LogoutReply, error)
+	LogoutAll(context.Context, *LogoutAllRequest) (*LogoutReply, error)
+	OIDCCallback(context.Context, *OIDCCallbackRequest) (*UserReply, error)
+	PurgeArticle(context.Context, *PurgeArticleRequest) (*SingleArticlesReply, error)
+	PurgeComment(context.Context, *PurgeCommentRequest) (*SingleCommentReply, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*UserReply, error)
 	Register(context.Context, *RegisterRequest) (*UserReply, error)
+	RequestEmailVerification(context.Context, *RequestEmailVerificationRequest) (*EmptyReply, error)
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*EmptyReply, error)
+	RestoreArticle(context.Context, *RestoreArticleRequest) (*SingleArticlesReply, error)
+	RestoreComment(context.Context, *RestoreCommentRequest) (*SingleCommentReply, error)
+	RevokeOtherSessions(context.Context, *RevokeOtherSessionsRequest) (*EmptyReply, error)
+	RevokePersonalToken(context.Context, *RevokePersonalTokenRequest) (*EmptyReply, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*EmptyReply, error)
+	SetUserRole(context.Context, *SetUserRoleRequest) (*UserRoleReply, error)
+	StartOIDCLogin(context.Context, *StartOIDCLoginRequest) (*StartOIDCLoginReply, error)
 	UnfavoriteArticle(context.Context, *UnfavoriteArticleRequest) (*SingleArticlesReply, error)
+	UnfavoriteArticleBySlug(context.Context, *UnfavoriteArticleBySlugRequest) (*SingleArticlesReply, error)
 	UnfollowUser(context.Context, *UnfollowUserRequest) (*ProfileReply, error)
+	UnlinkIdentity(context.Context, *UnlinkIdentityRequest) (*EmptyReply, error)
+	UnlockUser(context.Context, *UnlockUserRequest) (*EmptyReply, error)
 	UpdateArticle(context.Context, *UpdateArticleRequest) (*SingleArticlesReply, error)
+	UpdateArticleBySlug(context.Context, *UpdateArticleBySlugRequest) (*SingleArticlesReply, error)
 	UpdateUser(context.Context, *UpdateUserRequest) (*UserReply, error)
 }
 
@@ -43,23 +78,58 @@ func RegisterRealworldHTTPServer(s *http.Server, srv RealworldHTTPServer) {
 	r := s.Route("/")
 	r.POST("/api/users/login", _Realworld_Login0_HTTP_Handler(srv))
 	r.POST("/api/users", _Realworld_Register0_HTTP_Handler(srv))
+	r.POST("/api/users/login/mfa", _Realworld_LoginMFA0_HTTP_Handler(srv))
 	r.GET("/api/user", _Realworld_GetCurrentUser0_HTTP_Handler(srv))
 	r.PUT("/api/user", _Realworld_UpdateUser0_HTTP_Handler(srv))
+	r.POST("/api/users/token/refresh", _Realworld_RefreshToken0_HTTP_Handler(srv))
+	r.POST("/api/users/logout", _Realworld_Logout0_HTTP_Handler(srv))
+	r.POST("/api/users/logout/all", _Realworld_LogoutAll0_HTTP_Handler(srv))
+	r.POST("/api/users/email/verification", _Realworld_RequestEmailVerification0_HTTP_Handler(srv))
+	r.POST("/api/users/email/verification/confirm", _Realworld_ConfirmEmailVerification0_HTTP_Handler(srv))
+	r.POST("/api/users/password/reset", _Realworld_RequestPasswordReset0_HTTP_Handler(srv))
+	r.POST("/api/users/password/reset/confirm", _Realworld_ConfirmPasswordReset0_HTTP_Handler(srv))
+	r.POST("/api/users/oidc/{provider}/start", _Realworld_StartOIDCLogin0_HTTP_Handler(srv))
+	r.POST("/api/users/oidc/{provider}/callback", _Realworld_OIDCCallback0_HTTP_Handler(srv))
+	r.GET("/api/user/identities", _Realworld_ListIdentities0_HTTP_Handler(srv))
+	r.DELETE("/api/user/identities/{provider}", _Realworld_UnlinkIdentity0_HTTP_Handler(srv))
+	r.POST("/api/user/tokens", _Realworld_CreatePersonalToken0_HTTP_Handler(srv))
+	r.GET("/api/user/tokens", _Realworld_ListPersonalTokens0_HTTP_Handler(srv))
+	r.DELETE("/api/user/tokens/{id}", _Realworld_RevokePersonalToken0_HTTP_Handler(srv))
+	r.GET("/api/user/sessions", _Realworld_ListSessions0_HTTP_Handler(srv))
+	r.DELETE("/api/user/sessions/{id}", _Realworld_RevokeSession0_HTTP_Handler(srv))
+	r.POST("/api/user/sessions/revoke-others", _Realworld_RevokeOtherSessions0_HTTP_Handler(srv))
+	r.POST("/api/user/totp", _Realworld_EnrollTOTP0_HTTP_Handler(srv))
+	r.POST("/api/user/totp/confirm", _Realworld_ConfirmTOTP0_HTTP_Handler(srv))
+	r.POST("/api/user/totp/disable", _Realworld_DisableTOTP0_HTTP_Handler(srv))
 	r.GET("/api/profiles/{user_id}", _Realworld_GetProfile0_HTTP_Handler(srv))
 	r.POST("/api/profiles/{user_id}/follow", _Realworld_FollowUser0_HTTP_Handler(srv))
 	r.POST("/api/profiles/{user_id}/follow", _Realworld_UnfollowUser0_HTTP_Handler(srv))
 	r.GET("/api/articles", _Realworld_ListArticles0_HTTP_Handler(srv))
 	r.GET("/api/articles/feed", _Realworld_FeedArticles0_HTTP_Handler(srv))
-	r.GET("/api/articles/{article_id}", _Realworld_GetArticle0_HTTP_Handler(srv))
+	r.GET("/api/articles/id/{article_id}", _Realworld_GetArticle0_HTTP_Handler(srv))
 	r.POST("/api/articles", _Realworld_CreateArticle0_HTTP_Handler(srv))
-	r.PUT("/api/articles/{article_id}", _Realworld_UpdateArticle0_HTTP_Handler(srv))
-	r.PUT("/api/articles/{article_id}", _Realworld_DeleteArticle0_HTTP_Handler(srv))
-	r.POST("/api/articles/{article_id}/comments", _Realworld_AddComments0_HTTP_Handler(srv))
-	r.GET("/api/articles/{article_id}/comments", _Realworld_GetComments0_HTTP_Handler(srv))
-	r.DELETE("/api/articles/{article_id}/comments/{comment_id}", _Realworld_DeleteComment0_HTTP_Handler(srv))
-	r.POST("/api/articles/{article_id}/favorite", _Realworld_FavoriteArticle0_HTTP_Handler(srv))
-	r.DELETE("/api/articles/favorite", _Realworld_UnfavoriteArticle0_HTTP_Handler(srv))
+	r.PUT("/api/articles/id/{article_id}", _Realworld_UpdateArticle0_HTTP_Handler(srv))
+	r.DELETE("/api/articles/id/{article_id}", _Realworld_DeleteArticle0_HTTP_Handler(srv))
+	r.POST("/api/articles/id/{article_id}/comments", _Realworld_AddComments0_HTTP_Handler(srv))
+	r.GET("/api/articles/id/{article_id}/comments", _Realworld_GetComments0_HTTP_Handler(srv))
+	r.DELETE("/api/articles/id/{article_id}/comments/{comment_id}", _Realworld_DeleteComment0_HTTP_Handler(srv))
+	r.POST("/api/articles/id/{article_id}/favorite", _Realworld_FavoriteArticle0_HTTP_Handler(srv))
+	r.DELETE("/api/articles/id/{article_id}/favorite", _Realworld_UnfavoriteArticle0_HTTP_Handler(srv))
+	r.POST("/api/articles/id/{article_id}/restore", _Realworld_RestoreArticle0_HTTP_Handler(srv))
+	r.DELETE("/api/articles/id/{article_id}/purge", _Realworld_PurgeArticle0_HTTP_Handler(srv))
+	r.POST("/api/articles/id/{article_id}/comments/{comment_id}/restore", _Realworld_RestoreComment0_HTTP_Handler(srv))
+	r.DELETE("/api/articles/id/{article_id}/comments/{comment_id}/purge", _Realworld_PurgeComment0_HTTP_Handler(srv))
 	r.GET("/api/tags", _Realworld_GetTags0_HTTP_Handler(srv))
+	r.GET("/api/articles/{slug}", _Realworld_GetArticleBySlug0_HTTP_Handler(srv))
+	r.PUT("/api/articles/{slug}", _Realworld_UpdateArticleBySlug0_HTTP_Handler(srv))
+	r.DELETE("/api/articles/{slug}", _Realworld_DeleteArticleBySlug0_HTTP_Handler(srv))
+	r.POST("/api/articles/{slug}/comments", _Realworld_AddCommentsBySlug0_HTTP_Handler(srv))
+	r.GET("/api/articles/{slug}/comments", _Realworld_GetCommentsBySlug0_HTTP_Handler(srv))
+	r.DELETE("/api/articles/{slug}/comments/{comment_id}", _Realworld_DeleteCommentBySlug0_HTTP_Handler(srv))
+	r.POST("/api/articles/{slug}/favorite", _Realworld_FavoriteArticleBySlug0_HTTP_Handler(srv))
+	r.DELETE("/api/articles/{slug}/favorite", _Realworld_UnfavoriteArticleBySlug0_HTTP_Handler(srv))
+	r.POST("/api/admin/users/{username}/unlock", _Realworld_UnlockUser0_HTTP_Handler(srv))
+	r.PUT("/api/admin/users/{username}/role", _Realworld_SetUserRole0_HTTP_Handler(srv))
 }
 
 func _Realworld_Login0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
@@ -100,6 +170,25 @@ func _Realworld_Register0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Co
 	}
 }
 
+func _Realworld_LoginMFA0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LoginMFARequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/LoginMFA")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LoginMFA(ctx, req.(*LoginMFARequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UserReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Realworld_GetCurrentUser0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetCurrentUserRequest
@@ -138,397 +227,1255 @@ func _Realworld_UpdateUser0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.
 	}
 }
 
-func _Realworld_GetProfile0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+func _Realworld_RefreshToken0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetProfileRequest
-		if err := ctx.BindQuery(&in); err != nil {
-			return err
-		}
-		if err := ctx.BindVars(&in); err != nil {
+		var in RefreshTokenRequest
+		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, "/realworld.v1.Realworld/GetProfile")
+		http.SetOperation(ctx, "/realworld.v1.Realworld/RefreshToken")
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetProfile(ctx, req.(*GetProfileRequest))
+			return srv.RefreshToken(ctx, req.(*RefreshTokenRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*ProfileReply)
+		reply := out.(*UserReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Realworld_FollowUser0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+func _Realworld_Logout0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in FollowUserRequest
+		var in LogoutRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		if err := ctx.BindVars(&in); err != nil {
-			return err
-		}
-		http.SetOperation(ctx, "/realworld.v1.Realworld/FollowUser")
+		http.SetOperation(ctx, "/realworld.v1.Realworld/Logout")
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.FollowUser(ctx, req.(*FollowUserRequest))
+			return srv.Logout(ctx, req.(*LogoutRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*ProfileReply)
+		reply := out.(*LogoutReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Realworld_UnfollowUser0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+func _Realworld_LogoutAll0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in UnfollowUserRequest
+		var in LogoutAllRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		if err := ctx.BindVars(&in); err != nil {
-			return err
-		}
-		http.SetOperation(ctx, "/realworld.v1.Realworld/UnfollowUser")
+		http.SetOperation(ctx, "/realworld.v1.Realworld/LogoutAll")
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.UnfollowUser(ctx, req.(*UnfollowUserRequest))
+			return srv.LogoutAll(ctx, req.(*LogoutAllRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*ProfileReply)
+		reply := out.(*LogoutReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Realworld_ListArticles0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+func _Realworld_RequestEmailVerification0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in ListArticlesRequest
-		if err := ctx.BindQuery(&in); err != nil {
+		var in RequestEmailVerificationRequest
+		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, "/realworld.v1.Realworld/ListArticles")
+		http.SetOperation(ctx, "/realworld.v1.Realworld/RequestEmailVerification")
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.ListArticles(ctx, req.(*ListArticlesRequest))
+			return srv.RequestEmailVerification(ctx, req.(*RequestEmailVerificationRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*MultipleArticlesReply)
+		reply := out.(*EmptyReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Realworld_FeedArticles0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+func _Realworld_ConfirmEmailVerification0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in FeedArticlesRequest
-		if err := ctx.BindQuery(&in); err != nil {
+		var in ConfirmEmailVerificationRequest
+		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, "/realworld.v1.Realworld/FeedArticles")
+		http.SetOperation(ctx, "/realworld.v1.Realworld/ConfirmEmailVerification")
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.FeedArticles(ctx, req.(*FeedArticlesRequest))
+			return srv.ConfirmEmailVerification(ctx, req.(*ConfirmEmailVerificationRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*MultipleArticlesReply)
+		reply := out.(*EmptyReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Realworld_GetArticle0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+func _Realworld_RequestPasswordReset0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetArticleRequest
-		if err := ctx.BindQuery(&in); err != nil {
-			return err
-		}
-		if err := ctx.BindVars(&in); err != nil {
+		var in RequestPasswordResetRequest
+		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, "/realworld.v1.Realworld/GetArticle")
+		http.SetOperation(ctx, "/realworld.v1.Realworld/RequestPasswordReset")
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetArticle(ctx, req.(*GetArticleRequest))
+			return srv.RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*SingleArticlesReply)
+		reply := out.(*EmptyReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Realworld_CreateArticle0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+func _Realworld_ConfirmPasswordReset0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in CreateArticleRequest
+		var in ConfirmPasswordResetRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, "/realworld.v1.Realworld/CreateArticle")
+		http.SetOperation(ctx, "/realworld.v1.Realworld/ConfirmPasswordReset")
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.CreateArticle(ctx, req.(*CreateArticleRequest))
+			return srv.ConfirmPasswordReset(ctx, req.(*ConfirmPasswordResetRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*SingleArticlesReply)
+		reply := out.(*EmptyReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Realworld_UpdateArticle0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+func _Realworld_StartOIDCLogin0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in UpdateArticleRequest
+		var in StartOIDCLoginRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, "/realworld.v1.Realworld/UpdateArticle")
+		http.SetOperation(ctx, "/realworld.v1.Realworld/StartOIDCLogin")
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.UpdateArticle(ctx, req.(*UpdateArticleRequest))
+			return srv.StartOIDCLogin(ctx, req.(*StartOIDCLoginRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*SingleArticlesReply)
+		reply := out.(*StartOIDCLoginReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Realworld_DeleteArticle0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+func _Realworld_OIDCCallback0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in DeleteArticleRequest
+		var in OIDCCallbackRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, "/realworld.v1.Realworld/DeleteArticle")
+		http.SetOperation(ctx, "/realworld.v1.Realworld/OIDCCallback")
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.DeleteArticle(ctx, req.(*DeleteArticleRequest))
+			return srv.OIDCCallback(ctx, req.(*OIDCCallbackRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*SingleArticlesReply)
+		reply := out.(*UserReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Realworld_AddComments0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+func _Realworld_ListIdentities0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in AddCommentsRequest
-		if err := ctx.Bind(&in); err != nil {
-			return err
-		}
-		if err := ctx.BindVars(&in); err != nil {
+		var in ListIdentitiesRequest
+		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, "/realworld.v1.Realworld/AddComments")
+		http.SetOperation(ctx, "/realworld.v1.Realworld/ListIdentities")
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.AddComments(ctx, req.(*AddCommentsRequest))
+			return srv.ListIdentities(ctx, req.(*ListIdentitiesRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*SingleCommentReply)
+		reply := out.(*ListIdentitiesReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Realworld_GetComments0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+func _Realworld_UnlinkIdentity0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetCommentsRequest
+		var in UnlinkIdentityRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, "/realworld.v1.Realworld/GetComments")
+		http.SetOperation(ctx, "/realworld.v1.Realworld/UnlinkIdentity")
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetComments(ctx, req.(*GetCommentsRequest))
+			return srv.UnlinkIdentity(ctx, req.(*UnlinkIdentityRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*MultipleCommentsReply)
+		reply := out.(*EmptyReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Realworld_DeleteComment0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+func _Realworld_CreatePersonalToken0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in DeleteCommentRequest
-		if err := ctx.BindQuery(&in); err != nil {
+		var in CreatePersonalTokenRequest
+		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		if err := ctx.BindVars(&in); err != nil {
+		http.SetOperation(ctx, "/realworld.v1.Realworld/CreatePersonalToken")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreatePersonalToken(ctx, req.(*CreatePersonalTokenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
 			return err
 		}
-		http.SetOperation(ctx, "/realworld.v1.Realworld/DeleteComment")
+		reply := out.(*PersonalTokenReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Realworld_ListPersonalTokens0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListPersonalTokensRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/ListPersonalTokens")
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.DeleteComment(ctx, req.(*DeleteCommentRequest))
+			return srv.ListPersonalTokens(ctx, req.(*ListPersonalTokensRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*SingleCommentReply)
+		reply := out.(*ListPersonalTokensReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Realworld_FavoriteArticle0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+func _Realworld_RevokePersonalToken0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in FavoriteArticleRequest
-		if err := ctx.Bind(&in); err != nil {
+		var in RevokePersonalTokenRequest
+		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, "/realworld.v1.Realworld/FavoriteArticle")
+		http.SetOperation(ctx, "/realworld.v1.Realworld/RevokePersonalToken")
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.FavoriteArticle(ctx, req.(*FavoriteArticleRequest))
+			return srv.RevokePersonalToken(ctx, req.(*RevokePersonalTokenRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*SingleArticlesReply)
+		reply := out.(*EmptyReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Realworld_UnfavoriteArticle0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+func _Realworld_ListSessions0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in UnfavoriteArticleRequest
+		var in ListSessionsRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, "/realworld.v1.Realworld/UnfavoriteArticle")
+		http.SetOperation(ctx, "/realworld.v1.Realworld/ListSessions")
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.UnfavoriteArticle(ctx, req.(*UnfavoriteArticleRequest))
+			return srv.ListSessions(ctx, req.(*ListSessionsRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*SingleArticlesReply)
+		reply := out.(*ListSessionsReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Realworld_GetTags0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+func _Realworld_RevokeSession0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetTagsRequest
+		var in RevokeSessionRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, "/realworld.v1.Realworld/GetTags")
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/RevokeSession")
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetTags(ctx, req.(*GetTagsRequest))
+			return srv.RevokeSession(ctx, req.(*RevokeSessionRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*ListTagsReply)
+		reply := out.(*EmptyReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-type RealworldHTTPClient interface {
-	AddComments(ctx context.Context, req *AddCommentsRequest, opts ...http.CallOption) (rsp *SingleCommentReply, err error)
-	CreateArticle(ctx context.Context, req *CreateArticleRequest, opts ...http.CallOption) (rsp *SingleArticlesReply, err error)
-	DeleteArticle(ctx context.Context, req *DeleteArticleRequest, opts ...http.CallOption) (rsp *SingleArticlesReply, err error)
-	DeleteComment(ctx context.Context, req *DeleteCommentRequest, opts ...http.CallOption) (rsp *SingleCommentReply, err error)
-	FavoriteArticle(ctx context.Context, req *FavoriteArticleRequest, opts ...http.CallOption) (rsp *SingleArticlesReply, err error)
-	FeedArticles(ctx context.Context, req *FeedArticlesRequest, opts ...http.CallOption) (rsp *MultipleArticlesReply, err error)
-	FollowUser(ctx context.Context, req *FollowUserRequest, opts ...http.CallOption) (rsp *ProfileReply, err error)
-	GetArticle(ctx context.Context, req *GetArticleRequest, opts ...http.CallOption) (rsp *SingleArticlesReply, err error)
-	GetComments(ctx context.Context, req *GetCommentsRequest, opts ...http.CallOption) (rsp *MultipleCommentsReply, err error)
-	GetCurrentUser(ctx context.Context, req *GetCurrentUserRequest, opts ...http.CallOption) (rsp *UserReply, err error)
-	GetProfile(ctx context.Context, req *GetProfileRequest, opts ...http.CallOption) (rsp *ProfileReply, err error)
-	GetTags(ctx context.Context, req *GetTagsRequest, opts ...http.CallOption) (rsp *ListTagsReply, err error)
-	ListArticles(ctx context.Context, req *ListArticlesRequest, opts ...http.CallOption) (rsp *MultipleArticlesReply, err error)
-	Login(ctx context.Context, req *LoginRequest, opts ...http.CallOption) (rsp *UserReply, err error)
-	Register(ctx context.Context, req *RegisterRequest, opts ...http.CallOption) (rsp *UserReply, err error)
-	UnfavoriteArticle(ctx context.Context, req *UnfavoriteArticleRequest, opts ...http.CallOption) (rsp *SingleArticlesReply, err error)
-	UnfollowUser(ctx context.Context, req *UnfollowUserRequest, opts ...http.CallOption) (rsp *ProfileReply, err error)
-	UpdateArticle(ctx context.Context, req *UpdateArticleRequest, opts ...http.CallOption) (rsp *SingleArticlesReply, err error)
-	UpdateUser(ctx context.Context, req *UpdateUserRequest, opts ...http.CallOption) (rsp *UserReply, err error)
-}
-
-type RealworldHTTPClientImpl struct {
-	cc *http.Client
+func _Realworld_RevokeOtherSessions0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeOtherSessionsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/RevokeOtherSessions")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeOtherSessions(ctx, req.(*RevokeOtherSessionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EmptyReply)
+		return ctx.Result(200, reply)
+	}
 }
 
-func NewRealworldHTTPClient(client *http.Client) RealworldHTTPClient {
-	return &RealworldHTTPClientImpl{client}
+func _Realworld_EnrollTOTP0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in EnrollTOTPRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/EnrollTOTP")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.EnrollTOTP(ctx, req.(*EnrollTOTPRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EnrollTOTPReply)
+		return ctx.Result(200, reply)
+	}
 }
 
-func (c *RealworldHTTPClientImpl) AddComments(ctx context.Context, in *AddCommentsRequest, opts ...http.CallOption) (*SingleCommentReply, error) {
-	var out SingleCommentReply
-	pattern := "/api/articles/{article_id}/comments"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation("/realworld.v1.Realworld/AddComments"))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _Realworld_ConfirmTOTP0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ConfirmTOTPRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/ConfirmTOTP")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ConfirmTOTP(ctx, req.(*ConfirmTOTPRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RecoveryCodesReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, err
 }
 
-func (c *RealworldHTTPClientImpl) CreateArticle(ctx context.Context, in *CreateArticleRequest, opts ...http.CallOption) (*SingleArticlesReply, error) {
-	var out SingleArticlesReply
-	pattern := "/api/articles"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation("/realworld.v1.Realworld/CreateArticle"))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _Realworld_DisableTOTP0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DisableTOTPRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/DisableTOTP")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DisableTOTP(ctx, req.(*DisableTOTPRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EmptyReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, err
 }
 
-func (c *RealworldHTTPClientImpl) DeleteArticle(ctx context.Context, in *DeleteArticleRequest, opts ...http.CallOption) (*SingleArticlesReply, error) {
-	var out SingleArticlesReply
-	pattern := "/api/articles/{article_id}"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation("/realworld.v1.Realworld/DeleteArticle"))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _Realworld_GetProfile0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetProfileRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/GetProfile")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetProfile(ctx, req.(*GetProfileRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ProfileReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, err
+}
+
+func _Realworld_FollowUser0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in FollowUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/FollowUser")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.FollowUser(ctx, req.(*FollowUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ProfileReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Realworld_UnfollowUser0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnfollowUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/UnfollowUser")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnfollowUser(ctx, req.(*UnfollowUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ProfileReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Realworld_ListArticles0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListArticlesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/ListArticles")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListArticles(ctx, req.(*ListArticlesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MultipleArticlesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Realworld_FeedArticles0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in FeedArticlesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/FeedArticles")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.FeedArticles(ctx, req.(*FeedArticlesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MultipleArticlesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Realworld_GetArticle0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetArticleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/GetArticle")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetArticle(ctx, req.(*GetArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticlesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Realworld_CreateArticle0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateArticleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/CreateArticle")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateArticle(ctx, req.(*CreateArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticlesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Realworld_UpdateArticle0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateArticleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/UpdateArticle")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateArticle(ctx, req.(*UpdateArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticlesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Realworld_DeleteArticle0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteArticleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/DeleteArticle")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteArticle(ctx, req.(*DeleteArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticlesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Realworld_AddComments0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddCommentsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/AddComments")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddComments(ctx, req.(*AddCommentsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleCommentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Realworld_GetComments0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCommentsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/GetComments")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetComments(ctx, req.(*GetCommentsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MultipleCommentsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Realworld_DeleteComment0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteCommentRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/DeleteComment")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteComment(ctx, req.(*DeleteCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleCommentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Realworld_FavoriteArticle0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in FavoriteArticleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/FavoriteArticle")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.FavoriteArticle(ctx, req.(*FavoriteArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticlesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Realworld_UnfavoriteArticle0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnfavoriteArticleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/UnfavoriteArticle")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnfavoriteArticle(ctx, req.(*UnfavoriteArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticlesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Realworld_RestoreArticle0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RestoreArticleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/RestoreArticle")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RestoreArticle(ctx, req.(*RestoreArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticlesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Realworld_PurgeArticle0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PurgeArticleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/PurgeArticle")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PurgeArticle(ctx, req.(*PurgeArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticlesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Realworld_RestoreComment0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RestoreCommentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/RestoreComment")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RestoreComment(ctx, req.(*RestoreCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleCommentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Realworld_PurgeComment0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PurgeCommentRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/PurgeComment")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PurgeComment(ctx, req.(*PurgeCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleCommentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Realworld_GetTags0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTagsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/GetTags")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTags(ctx, req.(*GetTagsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTagsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Realworld_GetArticleBySlug0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetArticleBySlugRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/GetArticleBySlug")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetArticleBySlug(ctx, req.(*GetArticleBySlugRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticlesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Realworld_UpdateArticleBySlug0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateArticleBySlugRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/UpdateArticleBySlug")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateArticleBySlug(ctx, req.(*UpdateArticleBySlugRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticlesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Realworld_DeleteArticleBySlug0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteArticleBySlugRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/DeleteArticleBySlug")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteArticleBySlug(ctx, req.(*DeleteArticleBySlugRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticlesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Realworld_AddCommentsBySlug0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddCommentsBySlugRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/AddCommentsBySlug")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddCommentsBySlug(ctx, req.(*AddCommentsBySlugRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleCommentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Realworld_GetCommentsBySlug0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCommentsBySlugRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/GetCommentsBySlug")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCommentsBySlug(ctx, req.(*GetCommentsBySlugRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MultipleCommentsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Realworld_DeleteCommentBySlug0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteCommentBySlugRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/DeleteCommentBySlug")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteCommentBySlug(ctx, req.(*DeleteCommentBySlugRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleCommentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Realworld_FavoriteArticleBySlug0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in FavoriteArticleBySlugRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/FavoriteArticleBySlug")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.FavoriteArticleBySlug(ctx, req.(*FavoriteArticleBySlugRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticlesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Realworld_UnfavoriteArticleBySlug0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnfavoriteArticleBySlugRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/UnfavoriteArticleBySlug")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnfavoriteArticleBySlug(ctx, req.(*UnfavoriteArticleBySlugRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticlesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Realworld_UnlockUser0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnlockUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/UnlockUser")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnlockUser(ctx, req.(*UnlockUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EmptyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Realworld_SetUserRole0_HTTP_Handler(srv RealworldHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetUserRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/realworld.v1.Realworld/SetUserRole")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetUserRole(ctx, req.(*SetUserRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UserRoleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type RealworldHTTPClient interface {
+	AddComments(ctx context.Context, req *AddCommentsRequest, opts ...http.CallOption) (rsp *SingleCommentReply, err error)
+	AddCommentsBySlug(ctx context.Context, req *AddCommentsBySlugRequest, opts ...http.CallOption) (rsp *SingleCommentReply, err error)
+	ConfirmEmailVerification(ctx context.Context, req *ConfirmEmailVerificationRequest, opts ...http.CallOption) (rsp *EmptyReply, err error)
+	ConfirmPasswordReset(ctx context.Context, req *ConfirmPasswordResetRequest, opts ...http.CallOption) (rsp *EmptyReply, err error)
+	ConfirmTOTP(ctx context.Context, req *ConfirmTOTPRequest, opts ...http.CallOption) (rsp *RecoveryCodesReply, err error)
+	CreateArticle(ctx context.Context, req *CreateArticleRequest, opts ...http.CallOption) (rsp *SingleArticlesReply, err error)
+	CreatePersonalToken(ctx context.Context, req *CreatePersonalTokenRequest, opts ...http.CallOption) (rsp *PersonalTokenReply, err error)
+	DeleteArticle(ctx context.Context, req *DeleteArticleRequest, opts ...http.CallOption) (rsp *SingleArticlesReply, err error)
+	DeleteArticleBySlug(ctx context.Context, req *DeleteArticleBySlugRequest, opts ...http.CallOption) (rsp *SingleArticlesReply, err error)
+	DeleteComment(ctx context.Context, req *DeleteCommentRequest, opts ...http.CallOption) (rsp *SingleCommentReply, err error)
+	DeleteCommentBySlug(ctx context.Context, req *DeleteCommentBySlugRequest, opts ...http.CallOption) (rsp *SingleCommentReply, err error)
+	DisableTOTP(ctx context.Context, req *DisableTOTPRequest, opts ...http.CallOption) (rsp *EmptyReply, err error)
+	EnrollTOTP(ctx context.Context, req *EnrollTOTPRequest, opts ...http.CallOption) (rsp *EnrollTOTPReply, err error)
+	FavoriteArticle(ctx context.Context, req *FavoriteArticleRequest, opts ...http.CallOption) (rsp *SingleArticlesReply, err error)
+	FavoriteArticleBySlug(ctx context.Context, req *FavoriteArticleBySlugRequest, opts ...http.CallOption) (rsp *SingleArticlesReply, err error)
+	FeedArticles(ctx context.Context, req *FeedArticlesRequest, opts ...http.CallOption) (rsp *MultipleArticlesReply, err error)
+	FollowUser(ctx context.Context, req *FollowUserRequest, opts ...http.CallOption) (rsp *ProfileReply, err error)
+	GetArticle(ctx context.Context, req *GetArticleRequest, opts ...http.CallOption) (rsp *SingleArticlesReply, err error)
+	GetArticleBySlug(ctx context.Context, req *GetArticleBySlugRequest, opts ...http.CallOption) (rsp *SingleArticlesReply, err error)
+	GetComments(ctx context.Context, req *GetCommentsRequest, opts ...http.CallOption) (rsp *MultipleCommentsReply, err error)
+	GetCommentsBySlug(ctx context.Context, req *GetCommentsBySlugRequest, opts ...http.CallOption) (rsp *MultipleCommentsReply, err error)
+	GetCurrentUser(ctx context.Context, req *GetCurrentUserRequest, opts ...http.CallOption) (rsp *UserReply, err error)
+	GetProfile(ctx context.Context, req *GetProfileRequest, opts ...http.CallOption) (rsp *ProfileReply, err error)
+	GetTags(ctx context.Context, req *GetTagsRequest, opts ...http.CallOption) (rsp *ListTagsReply, err error)
+	ListArticles(ctx context.Context, req *ListArticlesRequest, opts ...http.CallOption) (rsp *MultipleArticlesReply, err error)
+	ListIdentities(ctx context.Context, req *ListIdentitiesRequest, opts ...http.CallOption) (rsp *ListIdentitiesReply, err error)
+	ListPersonalTokens(ctx context.Context, req *ListPersonalTokensRequest, opts ...http.CallOption) (rsp *ListPersonalTokensReply, err error)
+	ListSessions(ctx context.Context, req *ListSessionsRequest, opts ...http.CallOption) (rsp *ListSessionsReply, err error)
+	Login(ctx context.Context, req *LoginRequest, opts ...http.CallOption) (rsp *UserReply, err error)
+	LoginMFA(ctx context.Context, req *LoginMFARequest, opts ...http.CallOption) (rsp *UserReply, err error)
+	Logout(ctx context.Context, req *LogoutRequest, opts ...http.CallOption) (rsp *LogoutReply, err error)
+	LogoutAll(ctx context.Context, req *LogoutAllRequest, opts ...http.CallOption) (rsp *LogoutReply, err error)
+	OIDCCallback(ctx context.Context, req *OIDCCallbackRequest, opts ...http.CallOption) (rsp *UserReply, err error)
+	PurgeArticle(ctx context.Context, req *PurgeArticleRequest, opts ...http.CallOption) (rsp *SingleArticlesReply, err error)
+	PurgeComment(ctx context.Context, req *PurgeCommentRequest, opts ...http.CallOption) (rsp *SingleCommentReply, err error)
+	RefreshToken(ctx context.Context, req *RefreshTokenRequest, opts ...http.CallOption) (rsp *UserReply, err error)
+	Register(ctx context.Context, req *RegisterRequest, opts ...http.CallOption) (rsp *UserReply, err error)
+	RequestEmailVerification(ctx context.Context, req *RequestEmailVerificationRequest, opts ...http.CallOption) (rsp *EmptyReply, err error)
+	RequestPasswordReset(ctx context.Context, req *RequestPasswordResetRequest, opts ...http.CallOption) (rsp *EmptyReply, err error)
+	RestoreArticle(ctx context.Context, req *RestoreArticleRequest, opts ...http.CallOption) (rsp *SingleArticlesReply, err error)
+	RestoreComment(ctx context.Context, req *RestoreCommentRequest, opts ...http.CallOption) (rsp *SingleCommentReply, err error)
+	RevokeOtherSessions(ctx context.Context, req *RevokeOtherSessionsRequest, opts ...http.CallOption) (rsp *EmptyReply, err error)
+	RevokePersonalToken(ctx context.Context, req *RevokePersonalTokenRequest, opts ...http.CallOption) (rsp *EmptyReply, err error)
+	RevokeSession(ctx context.Context, req *RevokeSessionRequest, opts ...http.CallOption) (rsp *EmptyReply, err error)
+	SetUserRole(ctx context.Context, req *SetUserRoleRequest, opts ...http.CallOption) (rsp *UserRoleReply, err error)
+	StartOIDCLogin(ctx context.Context, req *StartOIDCLoginRequest, opts ...http.CallOption) (rsp *StartOIDCLoginReply, err error)
+	UnfavoriteArticle(ctx context.Context, req *UnfavoriteArticleRequest, opts ...http.CallOption) (rsp *SingleArticlesReply, err error)
+	UnfavoriteArticleBySlug(ctx context.Context, req *UnfavoriteArticleBySlugRequest, opts ...http.CallOption) (rsp *SingleArticlesReply, err error)
+	UnfollowUser(ctx context.Context, req *UnfollowUserRequest, opts ...http.CallOption) (rsp *ProfileReply, err error)
+	UnlinkIdentity(ctx context.Context, req *UnlinkIdentityRequest, opts ...http.CallOption) (rsp *EmptyReply, err error)
+	UnlockUser(ctx context.Context, req *UnlockUserRequest, opts ...http.CallOption) (rsp *EmptyReply, err error)
+	UpdateArticle(ctx context.Context, req *UpdateArticleRequest, opts ...http.CallOption) (rsp *SingleArticlesReply, err error)
+	UpdateArticleBySlug(ctx context.Context, req *UpdateArticleBySlugRequest, opts ...http.CallOption) (rsp *SingleArticlesReply, err error)
+	UpdateUser(ctx context.Context, req *UpdateUserRequest, opts ...http.CallOption) (rsp *UserReply, err error)
+}
+
+type RealworldHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewRealworldHTTPClient(client *http.Client) RealworldHTTPClient {
+	return &RealworldHTTPClientImpl{client}
+}
+
+func (c *RealworldHTTPClientImpl) AddComments(ctx context.Context, in *AddCommentsRequest, opts ...http.CallOption) (*SingleCommentReply, error) {
+	var out SingleCommentReply
+	pattern := "/api/articles/id/{article_id}/comments"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/AddComments"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RealworldHTTPClientImpl) AddCommentsBySlug(ctx context.Context, in *AddCommentsBySlugRequest, opts ...http.CallOption) (*SingleCommentReply, error) {
+	var out SingleCommentReply
+	pattern := "/api/articles/{slug}/comments"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/AddCommentsBySlug"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RealworldHTTPClientImpl) ConfirmEmailVerification(ctx context.Context, in *ConfirmEmailVerificationRequest, opts ...http.CallOption) (*EmptyReply, error) {
+	var out EmptyReply
+	pattern := "/api/users/email/verification/confirm"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/ConfirmEmailVerification"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RealworldHTTPClientImpl) ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetRequest, opts ...http.CallOption) (*EmptyReply, error) {
+	var out EmptyReply
+	pattern := "/api/users/password/reset/confirm"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/ConfirmPasswordReset"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RealworldHTTPClientImpl) ConfirmTOTP(ctx context.Context, in *ConfirmTOTPRequest, opts ...http.CallOption) (*RecoveryCodesReply, error) {
+	var out RecoveryCodesReply
+	pattern := "/api/user/totp/confirm"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/ConfirmTOTP"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RealworldHTTPClientImpl) CreateArticle(ctx context.Context, in *CreateArticleRequest, opts ...http.CallOption) (*SingleArticlesReply, error) {
+	var out SingleArticlesReply
+	pattern := "/api/articles"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/CreateArticle"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RealworldHTTPClientImpl) CreatePersonalToken(ctx context.Context, in *CreatePersonalTokenRequest, opts ...http.CallOption) (*PersonalTokenReply, error) {
+	var out PersonalTokenReply
+	pattern := "/api/user/tokens"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/CreatePersonalToken"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RealworldHTTPClientImpl) DeleteArticle(ctx context.Context, in *DeleteArticleRequest, opts ...http.CallOption) (*SingleArticlesReply, error) {
+	var out SingleArticlesReply
+	pattern := "/api/articles/id/{article_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/DeleteArticle"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RealworldHTTPClientImpl) DeleteArticleBySlug(ctx context.Context, in *DeleteArticleBySlugRequest, opts ...http.CallOption) (*SingleArticlesReply, error) {
+	var out SingleArticlesReply
+	pattern := "/api/articles/{slug}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/DeleteArticleBySlug"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
 }
 
 func (c *RealworldHTTPClientImpl) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...http.CallOption) (*SingleCommentReply, error) {
 	var out SingleCommentReply
-	pattern := "/api/articles/{article_id}/comments/{comment_id}"
+	pattern := "/api/articles/id/{article_id}/comments/{comment_id}"
 	path := binding.EncodeURL(pattern, in, true)
 	opts = append(opts, http.Operation("/realworld.v1.Realworld/DeleteComment"))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RealworldHTTPClientImpl) DeleteCommentBySlug(ctx context.Context, in *DeleteCommentBySlugRequest, opts ...http.CallOption) (*SingleCommentReply, error) {
+	var out SingleCommentReply
+	pattern := "/api/articles/{slug}/comments/{comment_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/DeleteCommentBySlug"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RealworldHTTPClientImpl) DisableTOTP(ctx context.Context, in *DisableTOTPRequest, opts ...http.CallOption) (*EmptyReply, error) {
+	var out EmptyReply
+	pattern := "/api/user/totp/disable"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/DisableTOTP"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RealworldHTTPClientImpl) EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...http.CallOption) (*EnrollTOTPReply, error) {
+	var out EnrollTOTPReply
+	pattern := "/api/user/totp"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/EnrollTOTP"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -537,7 +1484,7 @@ func (c *RealworldHTTPClientImpl) DeleteComment(ctx context.Context, in *DeleteC
 
 func (c *RealworldHTTPClientImpl) FavoriteArticle(ctx context.Context, in *FavoriteArticleRequest, opts ...http.CallOption) (*SingleArticlesReply, error) {
 	var out SingleArticlesReply
-	pattern := "/api/articles/{article_id}/favorite"
+	pattern := "/api/articles/id/{article_id}/favorite"
 	path := binding.EncodeURL(pattern, in, false)
 	opts = append(opts, http.Operation("/realworld.v1.Realworld/FavoriteArticle"))
 	opts = append(opts, http.PathTemplate(pattern))
@@ -548,6 +1495,19 @@ func (c *RealworldHTTPClientImpl) FavoriteArticle(ctx context.Context, in *Favor
 	return &out, err
 }
 
+func (c *RealworldHTTPClientImpl) FavoriteArticleBySlug(ctx context.Context, in *FavoriteArticleBySlugRequest, opts ...http.CallOption) (*SingleArticlesReply, error) {
+	var out SingleArticlesReply
+	pattern := "/api/articles/{slug}/favorite"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/FavoriteArticleBySlug"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *RealworldHTTPClientImpl) FeedArticles(ctx context.Context, in *FeedArticlesRequest, opts ...http.CallOption) (*MultipleArticlesReply, error) {
 	var out MultipleArticlesReply
 	pattern := "/api/articles/feed"
@@ -576,7 +1536,7 @@ func (c *RealworldHTTPClientImpl) FollowUser(ctx context.Context, in *FollowUser
 
 func (c *RealworldHTTPClientImpl) GetArticle(ctx context.Context, in *GetArticleRequest, opts ...http.CallOption) (*SingleArticlesReply, error) {
 	var out SingleArticlesReply
-	pattern := "/api/articles/{article_id}"
+	pattern := "/api/articles/id/{article_id}"
 	path := binding.EncodeURL(pattern, in, true)
 	opts = append(opts, http.Operation("/realworld.v1.Realworld/GetArticle"))
 	opts = append(opts, http.PathTemplate(pattern))
@@ -587,9 +1547,22 @@ func (c *RealworldHTTPClientImpl) GetArticle(ctx context.Context, in *GetArticle
 	return &out, err
 }
 
+func (c *RealworldHTTPClientImpl) GetArticleBySlug(ctx context.Context, in *GetArticleBySlugRequest, opts ...http.CallOption) (*SingleArticlesReply, error) {
+	var out SingleArticlesReply
+	pattern := "/api/articles/{slug}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/GetArticleBySlug"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *RealworldHTTPClientImpl) GetComments(ctx context.Context, in *GetCommentsRequest, opts ...http.CallOption) (*MultipleCommentsReply, error) {
 	var out MultipleCommentsReply
-	pattern := "/api/articles/{article_id}/comments"
+	pattern := "/api/articles/id/{article_id}/comments"
 	path := binding.EncodeURL(pattern, in, true)
 	opts = append(opts, http.Operation("/realworld.v1.Realworld/GetComments"))
 	opts = append(opts, http.PathTemplate(pattern))
@@ -600,6 +1573,19 @@ func (c *RealworldHTTPClientImpl) GetComments(ctx context.Context, in *GetCommen
 	return &out, err
 }
 
+func (c *RealworldHTTPClientImpl) GetCommentsBySlug(ctx context.Context, in *GetCommentsBySlugRequest, opts ...http.CallOption) (*MultipleCommentsReply, error) {
+	var out MultipleCommentsReply
+	pattern := "/api/articles/{slug}/comments"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/GetCommentsBySlug"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *RealworldHTTPClientImpl) GetCurrentUser(ctx context.Context, in *GetCurrentUserRequest, opts ...http.CallOption) (*UserReply, error) {
 	var out UserReply
 	pattern := "/api/user"
@@ -652,6 +1638,45 @@ func (c *RealworldHTTPClientImpl) ListArticles(ctx context.Context, in *ListArti
 	return &out, err
 }
 
+func (c *RealworldHTTPClientImpl) ListIdentities(ctx context.Context, in *ListIdentitiesRequest, opts ...http.CallOption) (*ListIdentitiesReply, error) {
+	var out ListIdentitiesReply
+	pattern := "/api/user/identities"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/ListIdentities"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RealworldHTTPClientImpl) ListPersonalTokens(ctx context.Context, in *ListPersonalTokensRequest, opts ...http.CallOption) (*ListPersonalTokensReply, error) {
+	var out ListPersonalTokensReply
+	pattern := "/api/user/tokens"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/ListPersonalTokens"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RealworldHTTPClientImpl) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...http.CallOption) (*ListSessionsReply, error) {
+	var out ListSessionsReply
+	pattern := "/api/user/sessions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/ListSessions"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *RealworldHTTPClientImpl) Login(ctx context.Context, in *LoginRequest, opts ...http.CallOption) (*UserReply, error) {
 	var out UserReply
 	pattern := "/api/users/login"
@@ -665,6 +1690,97 @@ func (c *RealworldHTTPClientImpl) Login(ctx context.Context, in *LoginRequest, o
 	return &out, err
 }
 
+func (c *RealworldHTTPClientImpl) LoginMFA(ctx context.Context, in *LoginMFARequest, opts ...http.CallOption) (*UserReply, error) {
+	var out UserReply
+	pattern := "/api/users/login/mfa"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/LoginMFA"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RealworldHTTPClientImpl) Logout(ctx context.Context, in *LogoutRequest, opts ...http.CallOption) (*LogoutReply, error) {
+	var out LogoutReply
+	pattern := "/api/users/logout"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/Logout"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RealworldHTTPClientImpl) LogoutAll(ctx context.Context, in *LogoutAllRequest, opts ...http.CallOption) (*LogoutReply, error) {
+	var out LogoutReply
+	pattern := "/api/users/logout/all"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/LogoutAll"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RealworldHTTPClientImpl) OIDCCallback(ctx context.Context, in *OIDCCallbackRequest, opts ...http.CallOption) (*UserReply, error) {
+	var out UserReply
+	pattern := "/api/users/oidc/{provider}/callback"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/OIDCCallback"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RealworldHTTPClientImpl) PurgeArticle(ctx context.Context, in *PurgeArticleRequest, opts ...http.CallOption) (*SingleArticlesReply, error) {
+	var out SingleArticlesReply
+	pattern := "/api/articles/id/{article_id}/purge"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/PurgeArticle"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RealworldHTTPClientImpl) PurgeComment(ctx context.Context, in *PurgeCommentRequest, opts ...http.CallOption) (*SingleCommentReply, error) {
+	var out SingleCommentReply
+	pattern := "/api/articles/id/{article_id}/comments/{comment_id}/purge"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/PurgeComment"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RealworldHTTPClientImpl) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...http.CallOption) (*UserReply, error) {
+	var out UserReply
+	pattern := "/api/users/token/refresh"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/RefreshToken"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *RealworldHTTPClientImpl) Register(ctx context.Context, in *RegisterRequest, opts ...http.CallOption) (*UserReply, error) {
 	var out UserReply
 	pattern := "/api/users"
@@ -678,9 +1794,126 @@ func (c *RealworldHTTPClientImpl) Register(ctx context.Context, in *RegisterRequ
 	return &out, err
 }
 
+func (c *RealworldHTTPClientImpl) RequestEmailVerification(ctx context.Context, in *RequestEmailVerificationRequest, opts ...http.CallOption) (*EmptyReply, error) {
+	var out EmptyReply
+	pattern := "/api/users/email/verification"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/RequestEmailVerification"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RealworldHTTPClientImpl) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...http.CallOption) (*EmptyReply, error) {
+	var out EmptyReply
+	pattern := "/api/users/password/reset"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/RequestPasswordReset"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RealworldHTTPClientImpl) RestoreArticle(ctx context.Context, in *RestoreArticleRequest, opts ...http.CallOption) (*SingleArticlesReply, error) {
+	var out SingleArticlesReply
+	pattern := "/api/articles/id/{article_id}/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/RestoreArticle"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RealworldHTTPClientImpl) RestoreComment(ctx context.Context, in *RestoreCommentRequest, opts ...http.CallOption) (*SingleCommentReply, error) {
+	var out SingleCommentReply
+	pattern := "/api/articles/id/{article_id}/comments/{comment_id}/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/RestoreComment"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RealworldHTTPClientImpl) RevokeOtherSessions(ctx context.Context, in *RevokeOtherSessionsRequest, opts ...http.CallOption) (*EmptyReply, error) {
+	var out EmptyReply
+	pattern := "/api/user/sessions/revoke-others"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/RevokeOtherSessions"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RealworldHTTPClientImpl) RevokePersonalToken(ctx context.Context, in *RevokePersonalTokenRequest, opts ...http.CallOption) (*EmptyReply, error) {
+	var out EmptyReply
+	pattern := "/api/user/tokens/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/RevokePersonalToken"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RealworldHTTPClientImpl) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...http.CallOption) (*EmptyReply, error) {
+	var out EmptyReply
+	pattern := "/api/user/sessions/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/RevokeSession"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RealworldHTTPClientImpl) SetUserRole(ctx context.Context, in *SetUserRoleRequest, opts ...http.CallOption) (*UserRoleReply, error) {
+	var out UserRoleReply
+	pattern := "/api/admin/users/{username}/role"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/SetUserRole"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RealworldHTTPClientImpl) StartOIDCLogin(ctx context.Context, in *StartOIDCLoginRequest, opts ...http.CallOption) (*StartOIDCLoginReply, error) {
+	var out StartOIDCLoginReply
+	pattern := "/api/users/oidc/{provider}/start"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/StartOIDCLogin"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *RealworldHTTPClientImpl) UnfavoriteArticle(ctx context.Context, in *UnfavoriteArticleRequest, opts ...http.CallOption) (*SingleArticlesReply, error) {
 	var out SingleArticlesReply
-	pattern := "/api/articles/favorite"
+	pattern := "/api/articles/id/{article_id}/favorite"
 	path := binding.EncodeURL(pattern, in, true)
 	opts = append(opts, http.Operation("/realworld.v1.Realworld/UnfavoriteArticle"))
 	opts = append(opts, http.PathTemplate(pattern))
@@ -691,6 +1924,19 @@ func (c *RealworldHTTPClientImpl) UnfavoriteArticle(ctx context.Context, in *Unf
 	return &out, err
 }
 
+func (c *RealworldHTTPClientImpl) UnfavoriteArticleBySlug(ctx context.Context, in *UnfavoriteArticleBySlugRequest, opts ...http.CallOption) (*SingleArticlesReply, error) {
+	var out SingleArticlesReply
+	pattern := "/api/articles/{slug}/favorite"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/UnfavoriteArticleBySlug"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *RealworldHTTPClientImpl) UnfollowUser(ctx context.Context, in *UnfollowUserRequest, opts ...http.CallOption) (*ProfileReply, error) {
 	var out ProfileReply
 	pattern := "/api/profiles/{user_id}/follow"
@@ -704,9 +1950,35 @@ func (c *RealworldHTTPClientImpl) UnfollowUser(ctx context.Context, in *Unfollow
 	return &out, err
 }
 
+func (c *RealworldHTTPClientImpl) UnlinkIdentity(ctx context.Context, in *UnlinkIdentityRequest, opts ...http.CallOption) (*EmptyReply, error) {
+	var out EmptyReply
+	pattern := "/api/user/identities/{provider}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/UnlinkIdentity"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *RealworldHTTPClientImpl) UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...http.CallOption) (*EmptyReply, error) {
+	var out EmptyReply
+	pattern := "/api/admin/users/{username}/unlock"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/UnlockUser"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *RealworldHTTPClientImpl) UpdateArticle(ctx context.Context, in *UpdateArticleRequest, opts ...http.CallOption) (*SingleArticlesReply, error) {
 	var out SingleArticlesReply
-	pattern := "/api/articles/{article_id}"
+	pattern := "/api/articles/id/{article_id}"
 	path := binding.EncodeURL(pattern, in, false)
 	opts = append(opts, http.Operation("/realworld.v1.Realworld/UpdateArticle"))
 	opts = append(opts, http.PathTemplate(pattern))
@@ -717,6 +1989,19 @@ func (c *RealworldHTTPClientImpl) UpdateArticle(ctx context.Context, in *UpdateA
 	return &out, err
 }
 
+func (c *RealworldHTTPClientImpl) UpdateArticleBySlug(ctx context.Context, in *UpdateArticleBySlugRequest, opts ...http.CallOption) (*SingleArticlesReply, error) {
+	var out SingleArticlesReply
+	pattern := "/api/articles/{slug}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/realworld.v1.Realworld/UpdateArticleBySlug"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *RealworldHTTPClientImpl) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...http.CallOption) (*UserReply, error) {
 	var out UserReply
 	pattern := "/api/user"
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"context"
+	"demo/internal/conf"
+	"demo/internal/data"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 管理员子命令: admin bootstrap <email>, 将第一个管理员提升为 admin
+func runAdmin(c *conf.Data, logger log.Logger, args []string) error {
+	if len(args) != 2 || args[0] != "bootstrap" {
+		return fmt.Errorf("usage: demo admin bootstrap <email>")
+	}
+	// 要求迁移已执行, 否则 role 字段可能不存在
+	db, err := data.NewDB(c, logger)
+	if err != nil {
+		return err
+	}
+	if err := data.BootstrapAdmin(context.Background(), db, args[1]); err != nil {
+		return err
+	}
+	fmt.Printf("promoted %s to admin\n", args[1])
+	return nil
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"demo/internal/conf"
 	"flag"
+	"fmt"
 	"os"
 
 	"github.com/go-kratos/kratos/v2"
@@ -28,6 +29,10 @@ var (
 
 func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [-conf path] [migrate up [N] | down [N] | status | admin bootstrap <email>]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
 }
 
 func newApp(logger log.Logger, hs *http.Server, gs *grpc.Server) *kratos.App {
@@ -70,7 +75,21 @@ func main() {
 	if err := c.Scan(&bc); err != nil {
 		panic(err)
 	}
-	app, cleanup, err := initApp(bc.Server, bc.Data, bc.Jwt, logger)
+	if flag.Arg(0) == "migrate" {
+		if err := runMigrate(bc.Data, flag.Args()[1:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+	if flag.Arg(0) == "admin" {
+		if err := runAdmin(bc.Data, logger, flag.Args()[1:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+	app, cleanup, err := initApp(bc.Server, bc.Data, bc.Jwt, bc.Mail, bc.LoginThrottle, bc.Password, bc.Oidc, logger)
 	if err != nil {
 		panic(err)
 	}
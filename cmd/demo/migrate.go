@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"demo/internal/conf"
+	"demo/internal/data"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+)
+
+// 数据库迁移子命令: migrate up [N] | down [N] | status
+func runMigrate(c *conf.Data, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: demo migrate up [N] | down [N] | status")
+	}
+	db, err := data.OpenDB(c)
+	if err != nil {
+		return err
+	}
+	m, err := data.NewMigrator(db)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	// up 默认执行全部, down 默认回滚一个
+	n := 0
+	if args[0] == "down" {
+		n = 1
+	}
+	if len(args) > 1 {
+		if n, err = strconv.Atoi(args[1]); err != nil || n <= 0 {
+			return fmt.Errorf("invalid migration count: %s", args[1])
+		}
+	}
+	switch args[0] {
+	case "up":
+		applied, err := m.Up(ctx, n)
+		for _, mig := range applied {
+			fmt.Printf("applied %d_%s\n", mig.Version, mig.Name)
+		}
+		return err
+	case "down":
+		reverted, err := m.Down(ctx, n)
+		for _, mig := range reverted {
+			fmt.Printf("reverted %d_%s\n", mig.Version, mig.Name)
+		}
+		return err
+	case "status":
+		list, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+		for _, st := range list {
+			status, appliedAt := "pending", ""
+			if st.Applied {
+				status = "applied"
+				appliedAt = time.Unix(st.AppliedAt, 0).Format(time.RFC3339)
+			}
+			switch {
+			case st.Dirty:
+				status = "dirty"
+			case st.Missing:
+				status = "missing file"
+			case st.ChecksumMismatch:
+				status = "checksum mismatch"
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", st.Version, st.Name, status, appliedAt)
+		}
+		return w.Flush()
+	}
+	return fmt.Errorf("unknown migrate command: %s", args[0])
+}
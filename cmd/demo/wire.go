@@ -16,6 +16,6 @@ import (
 )
 
 // initApp init kratos application.
-func initApp(*conf.Server, *conf.Data, *conf.JWT, log.Logger) (*kratos.App, func(), error) {
+func initApp(*conf.Server, *conf.Data, *conf.JWT, *conf.Mail, *conf.LoginThrottle, *conf.Password, *conf.OIDC, log.Logger) (*kratos.App, func(), error) {
 	panic(wire.Build(server.ProviderSet, data.ProviderSet, biz.ProviderSet, service.ProviderSet, newApp))
 }
@@ -9,6 +9,8 @@ import (
 	"demo/internal/biz"
 	"demo/internal/conf"
 	"demo/internal/data"
+	"demo/internal/pkg/middleware/auth"
+	"demo/internal/pkg/middleware/clientip"
 	"demo/internal/server"
 	"demo/internal/service"
 	"github.com/go-kratos/kratos/v2"
@@ -18,22 +20,68 @@ import (
 // Injectors from wire.go:
 
 // initApp init kratos application.
-func initApp(confServer *conf.Server, confData *conf.Data, jwt *conf.JWT, logger log.Logger) (*kratos.App, func(), error) {
-	db := data.NewDB(confData)
+func initApp(confServer *conf.Server, confData *conf.Data, jwt *conf.JWT, mail *conf.Mail, loginThrottle *conf.LoginThrottle, password *conf.Password, oidc *conf.OIDC, logger log.Logger) (*kratos.App, func(), error) {
+	keySet, err := auth.NewKeySetFromConfig(jwt)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, err := data.NewDB(confData, logger)
+	if err != nil {
+		return nil, nil, err
+	}
 	dataData, cleanup, err := data.NewData(confData, logger, db)
 	if err != nil {
 		return nil, nil, err
 	}
+	tokenRepo := data.NewTokenRepo(dataData, logger)
+	sessionRepo := data.NewSessionRepo(dataData, logger)
 	userRepo := data.NewUserRepo(dataData, logger)
+	transaction := data.NewTransaction(dataData)
+	tokenUsecase := biz.NewTokenUsecase(tokenRepo, sessionRepo, userRepo, transaction, keySet, jwt, mail, logger)
+	personalTokenRepo := data.NewPersonalTokenRepo(dataData, logger)
+	personalTokenUsecase := biz.NewPersonalTokenUsecase(personalTokenRepo, userRepo, logger)
+	resolver, err := clientip.NewResolverFromConfig(confServer)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
 	profileRepo := data.NewProfileRepo(dataData, logger)
-	userUsecase := biz.NewUserUseCase(userRepo, profileRepo, jwt, logger)
+	actionTokenRepo := data.NewActionTokenRepo(dataData, logger)
+	mailer, err := data.NewMailer(mail, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	passwordManager, err := biz.NewPasswordManager(password, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	accountUsecase := biz.NewAccountUsecase(userRepo, actionTokenRepo, personalTokenRepo, tokenUsecase, transaction, mailer, keySet, mail, passwordManager, logger)
+	loginAttemptRepo, err := data.NewLoginAttemptRepo(loginThrottle, dataData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	loginGuard := biz.NewLoginGuard(loginAttemptRepo, loginThrottle, logger)
+	userUsecase := biz.NewUserUseCase(userRepo, profileRepo, tokenUsecase, accountUsecase, loginGuard, passwordManager, logger)
 	articleRepo := data.NewArticleRepo(dataData, logger)
 	commentRepo := data.NewCommentRepo(dataData, logger)
 	tagRepo := data.NewTagRepo(dataData, logger)
-	socialUsecase := biz.NewSocialUseCase(articleRepo, commentRepo, tagRepo, logger)
-	realworldService := service.NewRealworldService(userUsecase, socialUsecase, logger)
-	httpServer := server.NewHTTPServer(confServer, jwt, realworldService, logger)
-	grpcServer := server.NewGRPCServer(confServer, jwt, realworldService, logger)
+	favoriteRepo := data.NewFavoriteRepo(dataData, logger)
+	authorizer := biz.NewAuthorizer()
+	socialUsecase := biz.NewSocialUseCase(articleRepo, commentRepo, tagRepo, favoriteRepo, userRepo, profileRepo, authorizer, transaction, logger)
+	mfaRepo := data.NewMFARepo(dataData, logger)
+	mfaUsecase := biz.NewMFAUsecase(userRepo, mfaRepo, accountUsecase, tokenUsecase, transaction, loginGuard, passwordManager, logger)
+	identityRepo := data.NewIdentityRepo(dataData, logger)
+	oidcUsecase, err := biz.NewOIDCUsecase(oidc, userRepo, identityRepo, tokenUsecase, accountUsecase, transaction, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	realworldService := service.NewRealworldService(userUsecase, socialUsecase, tokenUsecase, accountUsecase, mfaUsecase, oidcUsecase, personalTokenUsecase, logger)
+	httpServer := server.NewHTTPServer(confServer, keySet, tokenUsecase, personalTokenUsecase, resolver, realworldService, logger)
+	grpcServer := server.NewGRPCServer(confServer, keySet, tokenUsecase, personalTokenUsecase, resolver, realworldService, logger)
 	app := newApp(logger, httpServer, grpcServer)
 	return app, func() {
 		cleanup()
@@ -3,21 +3,27 @@ module demo
 go 1.16
 
 require (
-	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/envoyproxy/protoc-gen-validate v0.6.7
 	github.com/go-kratos/kratos/v2 v2.2.1
+	github.com/go-sql-driver/mysql v1.6.0
 	github.com/golang-jwt/jwt/v4 v4.4.1
 	github.com/google/wire v0.5.0
 	github.com/gorilla/handlers v1.5.1
+	github.com/jackc/pgconn v1.10.1
 	github.com/jinzhu/now v1.1.5 // indirect
 	github.com/kr/pretty v0.2.0 // indirect
+	github.com/mattn/go-sqlite3 v1.14.9
+	github.com/mozillazg/go-pinyin v0.19.0
 	golang.org/x/crypto v0.0.0-20220408190544-5352b0902921
 	golang.org/x/sys v0.0.0-20220319134239-a9b59b0215f8 // indirect
-	golang.org/x/text v0.3.7 // indirect
 	google.golang.org/genproto v0.0.0-20220322021311-435b647f9ef2
 	google.golang.org/grpc v1.45.0
 	google.golang.org/protobuf v1.27.1
 	gopkg.in/check.v1 v1.0.0-20190902080502-41f04d3bba15 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
 	gorm.io/driver/mysql v1.3.3
+	gorm.io/driver/postgres v1.3.1
+	gorm.io/driver/sqlite v1.3.1
 	gorm.io/gorm v1.23.1
+	gorm.io/plugin/soft_delete v1.1.0
 )
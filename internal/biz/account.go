@@ -0,0 +1,281 @@
+package biz
+
+import (
+	"context"
+	"demo/internal/conf"
+	"demo/internal/errors"
+	"demo/internal/pkg/middleware/auth"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	defaultVerifyTTL = 24 * time.Hour
+	defaultResetTTL  = time.Hour
+	// 两步登录 challenge token 有效期
+	mfaChallengeTTL = 5 * time.Minute
+	// 公开接口向同一用户发送同类邮件的最小间隔
+	mailCooldown = time.Minute
+)
+
+// 一次性 token 的用途, 签发时写入 aud
+const (
+	PurposeVerifyEmail   = "verify_email"
+	PurposeResetPassword = "reset_password"
+	PurposeMFALogin      = "mfa_login"
+)
+
+// Mail 待发送的邮件
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer 发送邮件, 由 data 层提供 smtp 与 outbox 实现
+type Mailer interface {
+	Send(ctx context.Context, m *Mail) error
+}
+
+// ActionToken 邮箱验证、重置密码等一次性 token 的使用记录
+type ActionToken struct {
+	ID        int
+	Jti       string
+	UserID    int
+	Purpose   string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+type ActionTokenRepo interface {
+	CreateActionToken(ctx context.Context, t *ActionToken) error
+	// 标记 token 已使用, 返回是否由本次调用使用(已使用、已失效或已过期返回 false)
+	UseActionToken(ctx context.Context, jti, purpose string) (bool, error)
+	// 使用户未使用的同类 token 全部失效, 只保留最新签发的一个
+	InvalidateActionTokens(ctx context.Context, userId int, purpose string) error
+	// 用户最近一次签发同类 token 的时间, 未签发过时返回零值
+	LastActionTokenIssuedAt(ctx context.Context, userId int, purpose string) (time.Time, error)
+}
+
+// 一次性 token 的 claims, 邮箱变更后旧 token 失效
+type actionClaims struct {
+	Email string `json:"email"`
+	jwt.StandardClaims
+}
+
+type AccountUsecase struct {
+	ur     UserRepo
+	atr    ActionTokenRepo
+	ptr    PersonalTokenRepo
+	tu     *TokenUsecase
+	tx     Transaction
+	mailer Mailer
+	ks     *auth.KeySet
+	mc     *conf.Mail
+	pm     *PasswordManager
+	log    *log.Helper
+}
+
+func NewAccountUsecase(ur UserRepo, atr ActionTokenRepo, ptr PersonalTokenRepo, tu *TokenUsecase, tx Transaction, mailer Mailer, ks *auth.KeySet, mc *conf.Mail, pm *PasswordManager, logger log.Logger) *AccountUsecase {
+	return &AccountUsecase{ur: ur, atr: atr, ptr: ptr, tu: tu, tx: tx, mailer: mailer, ks: ks, mc: mc, pm: pm, log: log.NewHelper(logger)}
+}
+
+// RequireVerification 未验证邮箱的用户是否禁止登录
+func (a *AccountUsecase) RequireVerification() bool {
+	return a.mc.GetRequireVerification()
+}
+
+func (a *AccountUsecase) ttl(purpose string) time.Duration {
+	switch purpose {
+	case PurposeMFALogin:
+		return mfaChallengeTTL
+	case PurposeResetPassword:
+		if d := a.mc.GetResetTtl(); d != nil && d.AsDuration() > 0 {
+			return d.AsDuration()
+		}
+		return defaultResetTTL
+	}
+	if d := a.mc.GetVerifyTtl(); d != nil && d.AsDuration() > 0 {
+		return d.AsDuration()
+	}
+	return defaultVerifyTTL
+}
+
+// 邮件中的链接, 如 https://example.com/verify-email?token=xxx
+func (a *AccountUsecase) link(path, token string) string {
+	return a.mc.GetBaseUrl() + path + "?token=" + url.QueryEscape(token)
+}
+
+// 签发一次性 token, 同一用途的旧 token 随即失效
+func (a *AccountUsecase) issue(ctx context.Context, u *User, purpose string) (string, error) {
+	now := time.Now()
+	claims := actionClaims{
+		Email: u.Email,
+		StandardClaims: jwt.StandardClaims{
+			Id:        auth.NewTokenID(),
+			Audience:  purpose,
+			Subject:   strconv.Itoa(u.UserID),
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(a.ttl(purpose)).Unix(),
+		},
+	}
+	token, err := a.ks.Sign(claims)
+	if err != nil {
+		return "", err
+	}
+	err = a.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := a.atr.InvalidateActionTokens(ctx, u.UserID, purpose); err != nil {
+			return err
+		}
+		return a.atr.CreateActionToken(ctx, &ActionToken{
+			Jti:       claims.Id,
+			UserID:    u.UserID,
+			Purpose:   purpose,
+			ExpiresAt: time.Unix(claims.ExpiresAt, 0),
+		})
+	})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// 校验并使用一次性 token, 返回 token 对应的用户; 需在事务中调用
+func (a *AccountUsecase) consume(ctx context.Context, token, purpose string) (*User, error) {
+	invalid := errors.BadRequest("token", "invalid or expired")
+	if token == "" {
+		return nil, invalid
+	}
+	claims := &actionClaims{}
+	t, err := jwt.ParseWithClaims(token, claims, a.ks.Keyfunc)
+	if err != nil || !t.Valid || !claims.VerifyAudience(purpose, true) {
+		return nil, invalid
+	}
+	userId, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		return nil, invalid
+	}
+	ok, err := a.atr.UseActionToken(ctx, claims.Id, purpose)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, invalid
+	}
+	u, err := a.ur.GetUserByUserID(ctx, userId)
+	if err != nil || u.Email != claims.Email {
+		return nil, invalid
+	}
+	return u, nil
+}
+
+// SendVerificationEmail 发送邮箱验证邮件
+func (a *AccountUsecase) SendVerificationEmail(ctx context.Context, u *User) error {
+	token, err := a.issue(ctx, u, PurposeVerifyEmail)
+	if err != nil {
+		return err
+	}
+	return a.mailer.Send(ctx, &Mail{
+		To:      u.Email,
+		Subject: "Verify your email",
+		Body: fmt.Sprintf("Hi %s,\n\nPlease verify your email address by opening the link below:\n\n%s\n\nThe link expires in %s.\n",
+			u.Username, a.link("/verify-email", token), a.ttl(PurposeVerifyEmail)),
+	})
+}
+
+// 公开接口按邮箱查找邮件收件人; 邮箱未注册或距上次发送同类邮件不足 mailCooldown 时返回 nil,
+// 调用方同样返回成功, 避免暴露已注册的邮箱或被用来向他人邮箱刷邮件
+func (a *AccountUsecase) recipient(ctx context.Context, email, purpose string) (*User, error) {
+	u, err := a.ur.GetUserByEmail(ctx, email)
+	if errors.IsNotFound(err) {
+		a.log.Infof("%s requested for unknown email %s", purpose, email)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	last, err := a.atr.LastActionTokenIssuedAt(ctx, u.UserID, purpose)
+	if err != nil {
+		return nil, err
+	}
+	if time.Since(last) < mailCooldown {
+		a.log.Infof("%s requested again within %s for user %d", purpose, mailCooldown, u.UserID)
+		return nil, nil
+	}
+	return u, nil
+}
+
+// RequestEmailVerification 按邮箱重新发送验证邮件, 未登录也可请求, 以便要求验证邮箱时丢失邮件的用户完成验证;
+// 邮箱未注册、已验证或请求过于频繁时同样返回成功
+func (a *AccountUsecase) RequestEmailVerification(ctx context.Context, email string) error {
+	u, err := a.recipient(ctx, email, PurposeVerifyEmail)
+	if err != nil || u == nil || u.EmailVerified {
+		return err
+	}
+	return a.SendVerificationEmail(ctx, u)
+}
+
+// ConfirmEmailVerification 使用邮件中的 token 完成邮箱验证
+func (a *AccountUsecase) ConfirmEmailVerification(ctx context.Context, token string) error {
+	return a.tx.InTx(ctx, func(ctx context.Context) error {
+		u, err := a.consume(ctx, token, PurposeVerifyEmail)
+		if err != nil {
+			return err
+		}
+		return a.ur.MarkEmailVerified(ctx, u.UserID)
+	})
+}
+
+// RequestPasswordReset 发送重置密码邮件, 邮箱未注册或请求过于频繁时同样返回成功
+func (a *AccountUsecase) RequestPasswordReset(ctx context.Context, email string) error {
+	u, err := a.recipient(ctx, email, PurposeResetPassword)
+	if err != nil || u == nil {
+		return err
+	}
+	token, err := a.issue(ctx, u, PurposeResetPassword)
+	if err != nil {
+		return err
+	}
+	return a.mailer.Send(ctx, &Mail{
+		To:      u.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Hi %s,\n\nOpen the link below to reset your password:\n\n%s\n\nThe link expires in %s. If you did not request a password reset, ignore this email.\n",
+			u.Username, a.link("/reset-password", token), a.ttl(PurposeResetPassword)),
+	})
+}
+
+// ConfirmPasswordReset 使用邮件中的 token 重置密码, 并吊销该用户已签发的全部 token 与个人访问令牌
+func (a *AccountUsecase) ConfirmPasswordReset(ctx context.Context, token, password string) error {
+	if err := a.pm.Validate(password); err != nil {
+		return err
+	}
+	hash, err := a.pm.Hash(password)
+	if err != nil {
+		return err
+	}
+	return a.tx.InTx(ctx, func(ctx context.Context) error {
+		u, err := a.consume(ctx, token, PurposeResetPassword)
+		if err != nil {
+			return err
+		}
+		if _, err := a.ur.UpdateUser(ctx, u.UserID, &User{PasswdHash: hash}); err != nil {
+			return err
+		}
+		// 能收到重置邮件说明邮箱属于该用户
+		if !u.EmailVerified {
+			if err := a.ur.MarkEmailVerified(ctx, u.UserID); err != nil {
+				return err
+			}
+		}
+		if err := a.tu.revokeAll(ctx, u.UserID); err != nil {
+			return err
+		}
+		// 密码可能已泄露, 个人访问令牌一并删除; 退出所有设备时不删除, 避免 refresh token 被重放时中断集成
+		return a.ptr.DeleteUserPersonalTokens(ctx, u.UserID)
+	})
+}
@@ -0,0 +1,231 @@
+package biz
+
+import (
+	"context"
+	"demo/internal/conf"
+	"demo/internal/pkg/middleware/auth"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 以 map 保存一次性 token 的 ActionTokenRepo, issued 记录全部签发过的 token
+type actionTokenStub struct {
+	ActionTokenRepo
+	tokens map[string]*ActionToken
+	issued []*ActionToken
+}
+
+func (r *actionTokenStub) CreateActionToken(ctx context.Context, t *ActionToken) error {
+	t.CreatedAt = time.Now()
+	cp := *t
+	r.tokens[t.Jti] = &cp
+	r.issued = append(r.issued, &cp)
+	return nil
+}
+
+func (r *actionTokenStub) LastActionTokenIssuedAt(ctx context.Context, userId int, purpose string) (time.Time, error) {
+	var last time.Time
+	for _, t := range r.issued {
+		if t.UserID == userId && t.Purpose == purpose && t.CreatedAt.After(last) {
+			last = t.CreatedAt
+		}
+	}
+	return last, nil
+}
+
+// 签发时间前移 d, 模拟时间流逝
+func (r *actionTokenStub) elapse(d time.Duration) {
+	for _, t := range r.issued {
+		t.CreatedAt = t.CreatedAt.Add(-d)
+	}
+}
+
+// 使用后删除, 与已失效的 token 一样无法再次使用
+func (r *actionTokenStub) UseActionToken(ctx context.Context, jti, purpose string) (bool, error) {
+	t, ok := r.tokens[jti]
+	if !ok || t.Purpose != purpose || time.Now().After(t.ExpiresAt) {
+		return false, nil
+	}
+	delete(r.tokens, jti)
+	return true, nil
+}
+
+func (r *actionTokenStub) InvalidateActionTokens(ctx context.Context, userId int, purpose string) error {
+	for jti, t := range r.tokens {
+		if t.UserID == userId && t.Purpose == purpose {
+			delete(r.tokens, jti)
+		}
+	}
+	return nil
+}
+
+// 记录已发送邮件的 Mailer
+type mailStub struct {
+	mails []*Mail
+}
+
+func (m *mailStub) Send(ctx context.Context, mail *Mail) error {
+	m.mails = append(m.mails, mail)
+	return nil
+}
+
+// 最近一封邮件正文中 token= 后的参数
+func (m *mailStub) token() string {
+	body := m.mails[len(m.mails)-1].Body
+	i := strings.Index(body, "token=")
+	if i < 0 {
+		return ""
+	}
+	return strings.Fields(body[i+len("token="):])[0]
+}
+
+func TestPasswordReset(t *testing.T) {
+	key, _ := auth.NewHMACKey("", "HS256", []byte("secret"))
+	ks, _ := auth.NewKeySet(key)
+	mc := &conf.Mail{BaseUrl: "http://localhost"}
+	ur := &userStub{users: map[int]*User{1: {UserID: 1, Email: "a@b.c", Username: "a"}}}
+	tr := &tokenStub{refresh: map[int]*RefreshToken{}, versions: map[int]int{}}
+	sr := &sessionStub{sessions: map[int]*Session{1: {ID: 1, UserID: 1, ExpiresAt: time.Now().Add(time.Hour)}}}
+	ptr := &personalTokenStub{tokens: map[int]*PersonalToken{}}
+	mailer := &mailStub{}
+	pm := newTestPasswordManager(t, &conf.Password{})
+	tu := NewTokenUsecase(tr, sr, ur, txStub{}, ks, &conf.JWT{}, mc, log.DefaultLogger)
+	atr := &actionTokenStub{tokens: map[string]*ActionToken{}}
+	au := NewAccountUsecase(ur, atr, ptr, tu, txStub{}, mailer, ks, mc, pm, log.DefaultLogger)
+	ctx := context.Background()
+	if err := ptr.CreatePersonalToken(ctx, &PersonalToken{UserID: 1, Name: "ci", TokenHash: "hash", Scopes: []string{auth.ScopeArticlesWrite}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := au.RequestPasswordReset(ctx, "x@b.c"); err != nil || len(mailer.mails) != 0 {
+		t.Fatalf("unknown email should succeed silently, got %v", err)
+	}
+	if err := au.RequestPasswordReset(ctx, "a@b.c"); err != nil {
+		t.Fatal(err)
+	}
+	first := mailer.token()
+	// 冷却时间内重复请求不发送邮件
+	if err := au.RequestPasswordReset(ctx, "a@b.c"); err != nil || len(mailer.mails) != 1 {
+		t.Fatalf("expected throttled request to succeed silently, got %d mails %v", len(mailer.mails), err)
+	}
+	atr.elapse(mailCooldown)
+	if err := au.RequestPasswordReset(ctx, "a@b.c"); err != nil {
+		t.Fatal(err)
+	}
+	// 重新请求后旧 token 失效
+	if err := au.ConfirmPasswordReset(ctx, first, "new-password"); errors.Code(err) != 400 {
+		t.Fatalf("expected superseded token to be rejected, got %v", err)
+	}
+	second := mailer.token()
+	if err := au.ConfirmEmailVerification(ctx, second); errors.Code(err) != 400 {
+		t.Fatalf("expected reset token to be rejected for verification, got %v", err)
+	}
+	if err := au.ConfirmPasswordReset(ctx, second, "new-password"); err != nil {
+		t.Fatal(err)
+	}
+	if err := au.ConfirmPasswordReset(ctx, second, "other-password"); errors.Code(err) != 400 {
+		t.Fatalf("expected used token to be rejected, got %v", err)
+	}
+	u := ur.users[1]
+	if !u.EmailVerified || !pm.Verify(u.PasswdHash, "new-password") {
+		t.Fatalf("unexpected user after reset: %+v", u)
+	}
+	// 已签发的 token 与会话全部失效
+	if tr.versions[1] != 1 || sr.sessions[1].RevokedAt.IsZero() {
+		t.Fatalf("expected tokens and sessions to be revoked, got version %d session %+v", tr.versions[1], sr.sessions[1])
+	}
+	// 重置密码后个人访问令牌一并删除
+	if ts, err := ptr.ListPersonalTokens(ctx, 1); err != nil || len(ts) != 0 {
+		t.Fatalf("expected personal tokens to be deleted, got %+v %v", ts, err)
+	}
+}
+
+func TestRequireVerification(t *testing.T) {
+	key, _ := auth.NewHMACKey("", "HS256", []byte("secret"))
+	ks, _ := auth.NewKeySet(key)
+	mc := &conf.Mail{BaseUrl: "http://localhost", RequireVerification: true}
+	ur := &userStub{users: map[int]*User{}}
+	mailer := &mailStub{}
+	pm := newTestPasswordManager(t, &conf.Password{})
+	tu := NewTokenUsecase(&tokenStub{refresh: map[int]*RefreshToken{}, versions: map[int]int{}}, &sessionStub{sessions: map[int]*Session{}}, ur, txStub{}, ks, &conf.JWT{}, mc, log.DefaultLogger)
+	au := NewAccountUsecase(ur, &actionTokenStub{tokens: map[string]*ActionToken{}}, nil, tu, txStub{}, mailer, ks, mc, pm, log.DefaultLogger)
+	uc := NewUserUseCase(ur, nil, tu, au, NewLoginGuard(nil, &conf.LoginThrottle{Disabled: true}, log.DefaultLogger), pm, log.DefaultLogger)
+	ctx := context.Background()
+
+	// 注册后不签发 token
+	ul, err := uc.Register(ctx, "a", "a@b.c", "password123")
+	if err != nil || ul.UserID == 0 || ul.Token != "" || ul.RefreshToken != "" {
+		t.Fatalf("expected registration without tokens, got %+v %v", ul, err)
+	}
+	if _, err := uc.Login(ctx, "a@b.c", "password123", "1.1.1.1"); errors.Code(err) != 403 {
+		t.Fatalf("expected unverified login to be rejected, got %v", err)
+	}
+	// 开启验证前签发的 refresh token 不能续期
+	u := ur.users[ul.UserID]
+	pair, err := tu.Issue(ctx, u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := tu.Refresh(ctx, pair.RefreshToken); errors.Code(err) != 403 {
+		t.Fatalf("expected unverified refresh to be rejected, got %v", err)
+	}
+	// 使用注册时发送的验证邮件
+	if err := au.ConfirmEmailVerification(ctx, mailer.token()); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := tu.Refresh(ctx, pair.RefreshToken); err != nil {
+		t.Fatalf("expected verified refresh to succeed, got %v", err)
+	}
+	if ul, err := uc.Login(ctx, "a@b.c", "password123", "1.1.1.1"); err != nil || ul.Token == "" {
+		t.Fatalf("expected verified login to succeed, got %+v %v", ul, err)
+	}
+}
+
+// 要求验证邮箱时, 丢失注册邮件的用户未登录也能按邮箱重新请求验证邮件
+func TestResendVerification(t *testing.T) {
+	key, _ := auth.NewHMACKey("", "HS256", []byte("secret"))
+	ks, _ := auth.NewKeySet(key)
+	mc := &conf.Mail{BaseUrl: "http://localhost", RequireVerification: true}
+	ur := &userStub{users: map[int]*User{}}
+	atr := &actionTokenStub{tokens: map[string]*ActionToken{}}
+	mailer := &mailStub{}
+	pm := newTestPasswordManager(t, &conf.Password{})
+	tu := NewTokenUsecase(&tokenStub{refresh: map[int]*RefreshToken{}, versions: map[int]int{}}, &sessionStub{sessions: map[int]*Session{}}, ur, txStub{}, ks, &conf.JWT{}, mc, log.DefaultLogger)
+	au := NewAccountUsecase(ur, atr, nil, tu, txStub{}, mailer, ks, mc, pm, log.DefaultLogger)
+	uc := NewUserUseCase(ur, nil, tu, au, NewLoginGuard(nil, &conf.LoginThrottle{Disabled: true}, log.DefaultLogger), pm, log.DefaultLogger)
+	ctx := context.Background()
+
+	if _, err := uc.Register(ctx, "a", "a@b.c", "password123"); err != nil {
+		t.Fatal(err)
+	}
+	lost := mailer.token()
+	if err := au.RequestEmailVerification(ctx, "x@b.c"); err != nil || len(mailer.mails) != 1 {
+		t.Fatalf("unknown email should succeed silently, got %d mails %v", len(mailer.mails), err)
+	}
+	// 注册邮件刚发出, 冷却时间内不重复发送
+	if err := au.RequestEmailVerification(ctx, "a@b.c"); err != nil || len(mailer.mails) != 1 {
+		t.Fatalf("expected throttled request to succeed silently, got %d mails %v", len(mailer.mails), err)
+	}
+	atr.elapse(mailCooldown)
+	if err := au.RequestEmailVerification(ctx, "a@b.c"); err != nil || len(mailer.mails) != 2 {
+		t.Fatalf("expected verification email to be resent, got %d mails %v", len(mailer.mails), err)
+	}
+	if err := au.ConfirmEmailVerification(ctx, lost); errors.Code(err) != 400 {
+		t.Fatalf("expected superseded token to be rejected, got %v", err)
+	}
+	if err := au.ConfirmEmailVerification(ctx, mailer.token()); err != nil {
+		t.Fatal(err)
+	}
+	if ul, err := uc.Login(ctx, "a@b.c", "password123", "1.1.1.1"); err != nil || ul.Token == "" {
+		t.Fatalf("expected verified login to succeed, got %+v %v", ul, err)
+	}
+	// 已验证的邮箱同样返回成功, 不再发送邮件
+	atr.elapse(mailCooldown)
+	if err := au.RequestEmailVerification(ctx, "a@b.c"); err != nil || len(mailer.mails) != 2 {
+		t.Fatalf("verified email should succeed silently, got %d mails %v", len(mailer.mails), err)
+	}
+}
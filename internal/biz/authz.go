@@ -0,0 +1,102 @@
+package biz
+
+import (
+	"context"
+	"demo/internal/errors"
+	"demo/internal/pkg/middleware/auth"
+)
+
+// Action 对资源的操作
+type Action string
+
+const (
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+	// 恢复已软删除的资源
+	ActionRestore Action = "restore"
+	// 彻底删除, 不可恢复
+	ActionPurge Action = "purge"
+)
+
+// Resource 受权限控制的资源
+type Resource struct {
+	Kind    string // article, comment
+	ID      int
+	OwnerID int // 资源作者
+	// 资源所属文章的作者, 文章作者可管理其文章下的评论
+	ArticleOwnerID int
+}
+
+// Policy 授权策略, 任一策略允许即可操作; 可用于管理员、版主越权
+type Policy interface {
+	Allow(ctx context.Context, user auth.LoginUser, action Action, res Resource) (bool, error)
+}
+
+// PolicyFunc 函数形式的 Policy
+type PolicyFunc func(ctx context.Context, user auth.LoginUser, action Action, res Resource) (bool, error)
+
+func (f PolicyFunc) Allow(ctx context.Context, user auth.LoginUser, action Action, res Resource) (bool, error) {
+	return f(ctx, user, action, res)
+}
+
+// OwnerPolicy 资源作者可修改删除, 文章作者可删除、恢复其文章下的评论
+func OwnerPolicy(ctx context.Context, user auth.LoginUser, action Action, res Resource) (bool, error) {
+	if res.OwnerID != 0 && res.OwnerID == user.UserID {
+		return true, nil
+	}
+	if res.Kind == "comment" && (action == ActionDelete || action == ActionRestore) && res.ArticleOwnerID != 0 && res.ArticleOwnerID == user.UserID {
+		return true, nil
+	}
+	return false, nil
+}
+
+// RolePolicy 管理员可执行任意操作, 版主可删除、恢复任意文章和评论
+func RolePolicy(ctx context.Context, user auth.LoginUser, action Action, res Resource) (bool, error) {
+	if user.Role == auth.RoleAdmin {
+		return true, nil
+	}
+	if user.Role == auth.RoleModerator && (action == ActionDelete || action == ActionRestore) {
+		return true, nil
+	}
+	return false, nil
+}
+
+type Authorizer struct {
+	policies []Policy
+}
+
+func NewAuthorizer() *Authorizer {
+	return &Authorizer{policies: []Policy{PolicyFunc(OwnerPolicy), PolicyFunc(RolePolicy)}}
+}
+
+// Use 追加授权策略
+func (a *Authorizer) Use(p ...Policy) {
+	a.policies = append(a.policies, p...)
+}
+
+// CurrentUser 获取当前登录用户, 未登录返回 401
+func (a *Authorizer) CurrentUser(ctx context.Context) (auth.LoginUser, error) {
+	u, ok := auth.FromContext(ctx)
+	if !ok {
+		return u, errors.Unauthorized("user", "not login")
+	}
+	return u, nil
+}
+
+// Authorize 校验当前登录用户能否对资源执行操作, 无权限返回 403
+func (a *Authorizer) Authorize(ctx context.Context, action Action, res Resource) (auth.LoginUser, error) {
+	u, err := a.CurrentUser(ctx)
+	if err != nil {
+		return u, err
+	}
+	for _, p := range a.policies {
+		ok, err := p.Allow(ctx, u, action, res)
+		if err != nil {
+			return u, err
+		}
+		if ok {
+			return u, nil
+		}
+	}
+	return u, errors.Forbidden(res.Kind, "no permission to "+string(action))
+}
@@ -0,0 +1,43 @@
+package biz
+
+import (
+	"context"
+	"demo/internal/pkg/middleware/auth"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestAuthorize(t *testing.T) {
+	az := NewAuthorizer()
+	ctx := auth.NewContext(context.Background(), auth.LoginUser{UserID: 1})
+	if _, err := az.Authorize(context.Background(), ActionUpdate, Resource{Kind: "article", OwnerID: 1}); errors.Code(err) != 401 {
+		t.Errorf("anonymous: got %v, want 401", err)
+	}
+	if _, err := az.Authorize(ctx, ActionUpdate, Resource{Kind: "article", OwnerID: 1}); err != nil {
+		t.Errorf("owner: got %v", err)
+	}
+	if _, err := az.Authorize(ctx, ActionUpdate, Resource{Kind: "article", OwnerID: 2}); errors.Code(err) != 403 {
+		t.Errorf("other: got %v, want 403", err)
+	}
+	if _, err := az.Authorize(ctx, ActionDelete, Resource{Kind: "comment", OwnerID: 2, ArticleOwnerID: 1}); err != nil {
+		t.Errorf("article owner deletes comment: got %v", err)
+	}
+	mod := auth.NewContext(context.Background(), auth.LoginUser{UserID: 3, Role: auth.RoleModerator})
+	if _, err := az.Authorize(mod, ActionDelete, Resource{Kind: "article", OwnerID: 2}); err != nil {
+		t.Errorf("moderator deletes article: got %v", err)
+	}
+	if _, err := az.Authorize(mod, ActionPurge, Resource{Kind: "article", OwnerID: 2}); errors.Code(err) != 403 {
+		t.Errorf("moderator purges article: got %v, want 403", err)
+	}
+	admin := auth.NewContext(context.Background(), auth.LoginUser{UserID: 4, Role: auth.RoleAdmin})
+	if _, err := az.Authorize(admin, ActionPurge, Resource{Kind: "article", OwnerID: 2}); err != nil {
+		t.Errorf("admin purges article: got %v", err)
+	}
+	az.Use(PolicyFunc(func(ctx context.Context, user auth.LoginUser, action Action, res Resource) (bool, error) {
+		return user.UserID == 1, nil
+	}))
+	if _, err := az.Authorize(ctx, ActionUpdate, Resource{Kind: "article", OwnerID: 2}); err != nil {
+		t.Errorf("policy override: got %v", err)
+	}
+}
@@ -1,6 +1,12 @@
 package biz
 
-import "github.com/google/wire"
+import (
+	"demo/internal/pkg/middleware/auth"
+
+	"github.com/google/wire"
+)
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewSocialUseCase, NewUserUseCase)
+var ProviderSet = wire.NewSet(NewSocialUseCase, NewUserUseCase, NewAuthorizer, NewTokenUsecase, NewAccountUsecase, NewMFAUsecase, NewLoginGuard, NewPasswordManager, NewOIDCUsecase, NewPersonalTokenUsecase, auth.NewKeySetFromConfig,
+	wire.Bind(new(auth.RevocationChecker), new(*TokenUsecase)),
+	wire.Bind(new(auth.PersonalTokenAuthenticator), new(*PersonalTokenUsecase)))
@@ -0,0 +1,247 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"demo/internal/errors"
+	"demo/internal/pkg/middleware/auth"
+	"demo/internal/pkg/totp"
+	"encoding/base32"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	totpIssuer        = "Realworld"
+	recoveryCodeCount = 10
+)
+
+// TOTPEnrollment 待确认的 TOTP 绑定信息
+type TOTPEnrollment struct {
+	Secret string
+	// otpauth:// 地址, 生成二维码供验证器应用扫描
+	URI string
+}
+
+type MFARepo interface {
+	// 返回 TOTP 密钥及是否已启用, 未绑定时密钥为空
+	GetTOTP(ctx context.Context, userId int) (secret string, enabled bool, err error)
+	// 保存待确认的密钥, 确认前登录不需要验证码
+	SetPendingTOTP(ctx context.Context, userId int, secret string) error
+	EnableTOTP(ctx context.Context, userId int) error
+	// 解绑 TOTP 并删除恢复码
+	DisableTOTP(ctx context.Context, userId int) error
+	// 替换用户的全部恢复码, 只保存哈希
+	ReplaceRecoveryCodes(ctx context.Context, userId int, hashes []string) error
+	// 使用恢复码, 返回是否存在且未使用
+	UseRecoveryCode(ctx context.Context, userId int, hash string) (bool, error)
+	// 记录已使用的 TOTP 时间窗口, step 不大于上次记录时返回 false
+	UseTOTPStep(ctx context.Context, userId int, step int64) (bool, error)
+}
+
+type MFAUsecase struct {
+	ur    UserRepo
+	mr    MFARepo
+	au    *AccountUsecase
+	tu    *TokenUsecase
+	tx    Transaction
+	guard *LoginGuard
+	pm    *PasswordManager
+	log   *log.Helper
+}
+
+func NewMFAUsecase(ur UserRepo, mr MFARepo, au *AccountUsecase, tu *TokenUsecase, tx Transaction, guard *LoginGuard, pm *PasswordManager, logger log.Logger) *MFAUsecase {
+	return &MFAUsecase{ur: ur, mr: mr, au: au, tu: tu, tx: tx, guard: guard, pm: pm, log: log.NewHelper(logger)}
+}
+
+// 生成随机恢复码, 格式 xxxxx-xxxxx
+func newRecoveryCode() string {
+	b := make([]byte, 7)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	s := strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b))[:10]
+	return s[:5] + "-" + s[5:]
+}
+
+// 忽略大小写、空格和连字符
+func normalizeRecoveryCode(code string) string {
+	return strings.NewReplacer(" ", "", "-", "").Replace(strings.ToLower(code))
+}
+
+// 校验 TOTP 验证码或恢复码, 恢复码使用后失效
+func (m *MFAUsecase) verifyCode(ctx context.Context, userId int, secret, code string) (bool, error) {
+	// 每个时间窗口的验证码只能使用一次
+	if step, ok := totp.Match(secret, code, time.Now()); ok {
+		return m.mr.UseTOTPStep(ctx, userId, step)
+	}
+	if normalized := normalizeRecoveryCode(code); len(normalized) == 10 {
+		return m.mr.UseRecoveryCode(ctx, userId, hashToken(normalized))
+	}
+	return false, nil
+}
+
+// EnrollTOTP 生成新的 TOTP 密钥, 需调用 ConfirmTOTP 校验验证码后生效
+func (m *MFAUsecase) EnrollTOTP(ctx context.Context) (*TOTPEnrollment, error) {
+	loginUser, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("user", "not login")
+	}
+	_, enabled, err := m.mr.GetTOTP(ctx, loginUser.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if enabled {
+		return nil, errors.ParamInvalid("totp", "already enabled")
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	if err := m.mr.SetPendingTOTP(ctx, loginUser.UserID, secret); err != nil {
+		return nil, err
+	}
+	return &TOTPEnrollment{
+		Secret: secret,
+		URI:    totp.ProvisioningURI(totpIssuer, loginUser.Email, secret),
+	}, nil
+}
+
+// ConfirmTOTP 校验验证码后启用 TOTP, 返回恢复码明文, 仅此一次
+func (m *MFAUsecase) ConfirmTOTP(ctx context.Context, code string) ([]string, error) {
+	loginUser, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("user", "not login")
+	}
+	secret, enabled, err := m.mr.GetTOTP(ctx, loginUser.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if enabled {
+		return nil, errors.ParamInvalid("totp", "already enabled")
+	}
+	if secret == "" {
+		return nil, errors.ParamInvalid("totp", "not enrolled")
+	}
+	step, ok := totp.Match(secret, code, time.Now())
+	if !ok {
+		return nil, errors.ParamInvalid("code", "invalid")
+	}
+	// 确认时使用的验证码不能再用于登录
+	if ok, err := m.mr.UseTOTPStep(ctx, loginUser.UserID, step); err != nil || !ok {
+		if err != nil {
+			return nil, err
+		}
+		return nil, errors.ParamInvalid("code", "invalid")
+	}
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		codes[i] = newRecoveryCode()
+		hashes[i] = hashToken(normalizeRecoveryCode(codes[i]))
+	}
+	err = m.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := m.mr.EnableTOTP(ctx, loginUser.UserID); err != nil {
+			return err
+		}
+		return m.mr.ReplaceRecoveryCodes(ctx, loginUser.UserID, hashes)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// DisableTOTP 解绑 TOTP, 需要重新输入密码及验证码或恢复码
+func (m *MFAUsecase) DisableTOTP(ctx context.Context, password, code string) error {
+	loginUser, ok := auth.FromContext(ctx)
+	if !ok {
+		return errors.Unauthorized("user", "not login")
+	}
+	u, err := m.ur.GetUserByUserID(ctx, loginUser.UserID)
+	if err != nil {
+		return err
+	}
+	if !m.pm.Verify(u.PasswdHash, password) {
+		return errors.Forbidden("password", "invalid")
+	}
+	return m.tx.InTx(ctx, func(ctx context.Context) error {
+		secret, enabled, err := m.mr.GetTOTP(ctx, u.UserID)
+		if err != nil {
+			return err
+		}
+		if !enabled {
+			return errors.ParamInvalid("totp", "not enabled")
+		}
+		ok, err := m.verifyCode(ctx, u.UserID, secret, code)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.Forbidden("code", "invalid")
+		}
+		return m.mr.DisableTOTP(ctx, u.UserID)
+	})
+}
+
+// LoginMFA 登录第二步, 使用第一步返回的 challenge token 与验证码或恢复码换取 token;
+// 验证码错误计入登录失败次数
+func (m *MFAUsecase) LoginMFA(ctx context.Context, mfaToken, code, ip string) (*UserLogin, error) {
+	var (
+		u       *User
+		pair    *TokenPair
+		badCode bool
+	)
+	err := m.tx.InTx(ctx, func(ctx context.Context) (err error) {
+		if u, err = m.au.consume(ctx, mfaToken, PurposeMFALogin); err != nil {
+			return errors.Unauthorized("mfa_token", "invalid or expired")
+		}
+		if err := m.guard.Check(ctx, u.Email, ip); err != nil {
+			return err
+		}
+		secret, enabled, err := m.mr.GetTOTP(ctx, u.UserID)
+		if err != nil {
+			return err
+		}
+		// 第一步之后解绑了 TOTP
+		if !enabled {
+			return errors.Unauthorized("mfa_token", "invalid or expired")
+		}
+		ok, err := m.verifyCode(ctx, u.UserID, secret, code)
+		if err != nil {
+			return err
+		}
+		// 返回错误使事务回滚, challenge token 可以重试直到过期
+		if !ok {
+			badCode = true
+			return errors.Unauthorized("code", "invalid")
+		}
+		pair, err = m.tu.Issue(ctx, u)
+		return err
+	})
+	// 在事务外记录, 避免随事务回滚
+	if badCode {
+		if err := m.guard.Fail(ctx, u.Email, ip); err != nil {
+			m.log.Errorf("record login failure: %v", err)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := m.guard.Succeed(ctx, u.Email); err != nil {
+		return nil, err
+	}
+	return &UserLogin{
+		UserID:        u.UserID,
+		Username:      u.Username,
+		Email:         u.Email,
+		Token:         pair.AccessToken,
+		RefreshToken:  pair.RefreshToken,
+		Bio:           u.Bio,
+		Image:         u.Image,
+		Role:          u.Role,
+		EmailVerified: u.EmailVerified,
+	}, nil
+}
@@ -0,0 +1,167 @@
+package biz
+
+import (
+	"context"
+	"demo/internal/conf"
+	"demo/internal/pkg/middleware/auth"
+	"demo/internal/pkg/totp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 以 map 保存 TOTP 状态的 MFARepo, 启用状态与 data 层一样保存在用户上
+type mfaStub struct {
+	MFARepo
+	ur      *userStub
+	secrets map[int]string
+	steps   map[int]int64
+	codes   map[int]map[string]bool
+}
+
+func (r *mfaStub) GetTOTP(ctx context.Context, userId int) (string, bool, error) {
+	return r.secrets[userId], r.ur.users[userId].TOTPEnabled, nil
+}
+
+func (r *mfaStub) SetPendingTOTP(ctx context.Context, userId int, secret string) error {
+	if !r.ur.users[userId].TOTPEnabled {
+		r.secrets[userId] = secret
+	}
+	return nil
+}
+
+func (r *mfaStub) EnableTOTP(ctx context.Context, userId int) error {
+	r.ur.users[userId].TOTPEnabled = true
+	return nil
+}
+
+func (r *mfaStub) DisableTOTP(ctx context.Context, userId int) error {
+	r.ur.users[userId].TOTPEnabled = false
+	delete(r.secrets, userId)
+	delete(r.codes, userId)
+	return nil
+}
+
+func (r *mfaStub) ReplaceRecoveryCodes(ctx context.Context, userId int, hashes []string) error {
+	r.codes[userId] = map[string]bool{}
+	for _, h := range hashes {
+		r.codes[userId][h] = true
+	}
+	return nil
+}
+
+func (r *mfaStub) UseRecoveryCode(ctx context.Context, userId int, hash string) (bool, error) {
+	if !r.codes[userId][hash] {
+		return false, nil
+	}
+	delete(r.codes[userId], hash)
+	return true, nil
+}
+
+func (r *mfaStub) UseTOTPStep(ctx context.Context, userId int, step int64) (bool, error) {
+	if step <= r.steps[userId] {
+		return false, nil
+	}
+	r.steps[userId] = step
+	return true, nil
+}
+
+// 出错时恢复一次性 token 的 Transaction, 模拟事务回滚
+type actionTokenTx struct {
+	atr *actionTokenStub
+}
+
+func (tx actionTokenTx) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	saved := map[string]*ActionToken{}
+	for jti, t := range tx.atr.tokens {
+		saved[jti] = t
+	}
+	err := fn(ctx)
+	if err != nil {
+		tx.atr.tokens = saved
+	}
+	return err
+}
+
+func TestTOTPLogin(t *testing.T) {
+	key, _ := auth.NewHMACKey("", "HS256", []byte("secret"))
+	ks, _ := auth.NewKeySet(key)
+	pm := newTestPasswordManager(t, &conf.Password{})
+	hash, err := pm.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ur := &userStub{users: map[int]*User{1: {UserID: 1, Email: "a@b.c", Username: "a", PasswdHash: hash}}}
+	mr := &mfaStub{ur: ur, secrets: map[int]string{}, steps: map[int]int64{}, codes: map[int]map[string]bool{}}
+	atr := &actionTokenStub{tokens: map[string]*ActionToken{}}
+	tx := actionTokenTx{atr: atr}
+	guard := NewLoginGuard(nil, &conf.LoginThrottle{Disabled: true}, log.DefaultLogger)
+	tu := NewTokenUsecase(&tokenStub{refresh: map[int]*RefreshToken{}, versions: map[int]int{}}, &sessionStub{sessions: map[int]*Session{}}, ur, tx, ks, &conf.JWT{}, &conf.Mail{}, log.DefaultLogger)
+	au := NewAccountUsecase(ur, atr, nil, tu, tx, nil, ks, &conf.Mail{}, pm, log.DefaultLogger)
+	uc := NewUserUseCase(ur, nil, tu, au, guard, pm, log.DefaultLogger)
+	mu := NewMFAUsecase(ur, mr, au, tu, tx, guard, pm, log.DefaultLogger)
+	ctx := context.Background()
+
+	userCtx := auth.NewContext(ctx, auth.LoginUser{UserID: 1, Email: "a@b.c"})
+	e, err := mu.EnrollTOTP(userCtx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, _ := totp.Code(e.Secret, time.Now())
+	recovery, err := mu.ConfirmTOTP(userCtx, code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ul, err := uc.Login(ctx, "a@b.c", "password", "127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ul.Token != "" || ul.MFAToken == "" {
+		t.Fatalf("expected mfa challenge only, got %+v", ul)
+	}
+	// 验证码错误时 challenge token 仍可重试
+	if _, err := mu.LoginMFA(ctx, ul.MFAToken, "000000", "127.0.0.1"); errors.Code(err) != 401 {
+		t.Fatalf("expected 401 for wrong code, got %v", err)
+	}
+	ml, err := mu.LoginMFA(ctx, ul.MFAToken, strings.ToUpper(recovery[0]), "127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ml.Token == "" || ml.RefreshToken == "" {
+		t.Fatalf("expected tokens, got %+v", ml)
+	}
+	if _, err := mu.LoginMFA(ctx, ul.MFAToken, recovery[1], "127.0.0.1"); errors.Code(err) != 401 {
+		t.Fatalf("expected used challenge to be rejected, got %v", err)
+	}
+	// 同一时间窗口的验证码只能使用一次, 确认时用过的也不行
+	ul, err = uc.Login(ctx, "a@b.c", "password", "127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := mu.LoginMFA(ctx, ul.MFAToken, code, "127.0.0.1"); errors.Code(err) != 401 {
+		t.Fatalf("expected confirmed code to be rejected, got %v", err)
+	}
+	next, _ := totp.Code(e.Secret, time.Now().Add(totp.Period*time.Second))
+	if _, err := mu.LoginMFA(ctx, ul.MFAToken, next, "127.0.0.1"); err != nil {
+		t.Fatal(err)
+	}
+	ul, err = uc.Login(ctx, "a@b.c", "password", "127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := mu.LoginMFA(ctx, ul.MFAToken, next, "127.0.0.1"); errors.Code(err) != 401 {
+		t.Fatalf("expected replayed code to be rejected, got %v", err)
+	}
+	if err := mu.DisableTOTP(userCtx, "password", recovery[0]); errors.Code(err) != 403 {
+		t.Fatalf("expected used recovery code to be rejected, got %v", err)
+	}
+	if err := mu.DisableTOTP(userCtx, "password", recovery[1]); err != nil {
+		t.Fatal(err)
+	}
+	if ul, err = uc.Login(ctx, "a@b.c", "password", "127.0.0.1"); err != nil || ul.Token == "" {
+		t.Fatalf("expected direct login after disabling totp, got %+v %v", ul, err)
+	}
+}
@@ -0,0 +1,358 @@
+package biz
+
+import (
+	"context"
+	"demo/internal/conf"
+	"demo/internal/errors"
+	"demo/internal/pkg/middleware/auth"
+	"demo/internal/pkg/oidc"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultOIDCStateTTL = 10 * time.Minute
+	// 用户名重复时追加数字后缀的最大尝试次数
+	maxUsernameAttempts = 20
+)
+
+// LinkedIdentity 绑定到用户的第三方身份
+type LinkedIdentity struct {
+	ID       int
+	UserID   int
+	Provider string
+	// 提供方的用户 ID(sub)
+	Subject   string
+	Email     string
+	CreatedAt time.Time
+}
+
+// OIDCState 发起登录时保存, 回调时取出, 只能使用一次
+type OIDCState struct {
+	State    string
+	Provider string
+	// PKCE code_verifier, 只保存在服务端
+	Verifier string
+	Nonce    string
+	// 已登录用户发起时为绑定当前账号
+	UserID    int
+	ExpiresAt time.Time
+}
+
+type IdentityRepo interface {
+	// 不存在时返回 404
+	GetIdentity(ctx context.Context, provider, subject string) (*LinkedIdentity, error)
+	ListIdentities(ctx context.Context, userId int) ([]*LinkedIdentity, error)
+	CreateIdentity(ctx context.Context, li *LinkedIdentity) error
+	// 返回是否存在并已删除
+	DeleteIdentity(ctx context.Context, userId int, provider string) (bool, error)
+	CreateOIDCState(ctx context.Context, s *OIDCState) error
+	// 读取 state 但不删除, 不存在或已过期时返回 nil
+	GetOIDCState(ctx context.Context, state string) (*OIDCState, error)
+	// 取出并删除 state, 不存在或已过期时返回 nil
+	TakeOIDCState(ctx context.Context, state string) (*OIDCState, error)
+}
+
+// OIDCUsecase OpenID Connect 登录与第三方身份绑定
+type OIDCUsecase struct {
+	ur        UserRepo
+	ir        IdentityRepo
+	tu        *TokenUsecase
+	au        *AccountUsecase
+	tx        Transaction
+	providers map[string]*oidc.Client
+	stateTTL  time.Duration
+	log       *log.Helper
+}
+
+func NewOIDCUsecase(c *conf.OIDC, ur UserRepo, ir IdentityRepo, tu *TokenUsecase, au *AccountUsecase, tx Transaction, logger log.Logger) (*OIDCUsecase, error) {
+	o := &OIDCUsecase{
+		ur:        ur,
+		ir:        ir,
+		tu:        tu,
+		au:        au,
+		tx:        tx,
+		providers: map[string]*oidc.Client{},
+		stateTTL:  defaultOIDCStateTTL,
+		log:       log.NewHelper(logger),
+	}
+	if d := c.GetStateTtl(); d != nil && d.AsDuration() > 0 {
+		o.stateTTL = d.AsDuration()
+	}
+	for _, p := range c.GetProviders() {
+		if p.Name == "" || p.Issuer == "" || p.ClientId == "" || p.RedirectUrl == "" {
+			return nil, fmt.Errorf("oidc provider %q: name, issuer, client_id and redirect_url are required", p.Name)
+		}
+		if _, ok := o.providers[p.Name]; ok {
+			return nil, fmt.Errorf("duplicate oidc provider: %s", p.Name)
+		}
+		o.providers[p.Name] = oidc.NewClient(oidc.Config{
+			Issuer:       p.Issuer,
+			ClientID:     p.ClientId,
+			ClientSecret: p.ClientSecret,
+			RedirectURL:  p.RedirectUrl,
+			Scopes:       p.Scopes,
+		}, nil)
+	}
+	return o, nil
+}
+
+func (o *OIDCUsecase) client(provider string) (*oidc.Client, error) {
+	c, ok := o.providers[provider]
+	if !ok {
+		return nil, errors.NotFound("provider", "not found")
+	}
+	return c, nil
+}
+
+// StartLogin 返回提供方的授权地址; 已登录时回调后将身份绑定到当前用户
+func (o *OIDCUsecase) StartLogin(ctx context.Context, provider string) (string, error) {
+	c, err := o.client(provider)
+	if err != nil {
+		return "", err
+	}
+	s := &OIDCState{Provider: provider, ExpiresAt: time.Now().Add(o.stateTTL)}
+	if u, ok := auth.FromContext(ctx); ok {
+		s.UserID = u.UserID
+	}
+	for _, v := range []*string{&s.State, &s.Nonce, &s.Verifier} {
+		if *v, err = oidc.RandomString(); err != nil {
+			return "", err
+		}
+	}
+	authURL, err := c.AuthCodeURL(ctx, s.State, s.Nonce, s.Verifier)
+	if err != nil {
+		o.log.Errorf("oidc %s: %v", provider, err)
+		return "", errors.ServiceUnavailable("provider", "unavailable")
+	}
+	if err := o.ir.CreateOIDCState(ctx, s); err != nil {
+		return "", err
+	}
+	return authURL, nil
+}
+
+// Callback 校验提供方返回的 code 与 state 并登录, 身份未绑定时按以下顺序处理:
+// 发起时已登录则绑定到该用户; 邮箱已注册且双方均已验证则绑定; 邮箱未注册则创建用户
+func (o *OIDCUsecase) Callback(ctx context.Context, provider, code, state string) (*UserLogin, error) {
+	c, err := o.client(provider)
+	if err != nil {
+		return nil, err
+	}
+	s, err := o.ir.GetOIDCState(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil || s.Provider != provider {
+		return nil, errors.BadRequest("state", "invalid or expired")
+	}
+	// 绑定流程只能由发起绑定的用户完成, 防止他人的身份被绑定到发起者的账号.
+	// 校验通过后才取出 state, 其他用户的请求不会使发起者的 state 失效
+	if s.UserID != 0 {
+		if u, ok := auth.FromContext(ctx); !ok || u.UserID != s.UserID {
+			return nil, errors.Forbidden("state", "started by another user")
+		}
+	}
+	if s, err = o.ir.TakeOIDCState(ctx, state); err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, errors.BadRequest("state", "invalid or expired")
+	}
+	token, err := c.Exchange(ctx, code, s.Verifier)
+	if err != nil {
+		o.log.Warnf("oidc %s: %v", provider, err)
+		return nil, errors.Unauthorized("code", "invalid")
+	}
+	claims, err := c.Verify(ctx, token.IDToken, s.Nonce)
+	if err != nil {
+		o.log.Warnf("oidc %s: %v", provider, err)
+		return nil, errors.Unauthorized("id_token", "invalid")
+	}
+	var u *User
+	err = o.tx.InTx(ctx, func(ctx context.Context) (err error) {
+		u, err = o.resolveUser(ctx, provider, s.UserID, claims)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if o.au.RequireVerification() && !u.EmailVerified {
+		return nil, errors.Forbidden("email", "not verified")
+	}
+	if u.TOTPEnabled {
+		mfaToken, err := o.au.issue(ctx, u, PurposeMFALogin)
+		if err != nil {
+			return nil, err
+		}
+		return &UserLogin{UserID: u.UserID, Username: u.Username, Email: u.Email, MFAToken: mfaToken}, nil
+	}
+	pair, err := o.tu.Issue(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	return &UserLogin{
+		UserID:        u.UserID,
+		Username:      u.Username,
+		Email:         u.Email,
+		Token:         pair.AccessToken,
+		RefreshToken:  pair.RefreshToken,
+		Bio:           u.Bio,
+		Image:         u.Image,
+		Role:          u.Role,
+		EmailVerified: u.EmailVerified,
+	}, nil
+}
+
+// 查找或创建身份对应的用户, 需在事务中调用
+func (o *OIDCUsecase) resolveUser(ctx context.Context, provider string, linkUserId int, claims *oidc.Claims) (*User, error) {
+	li, err := o.ir.GetIdentity(ctx, provider, claims.Subject)
+	if err == nil {
+		if linkUserId != 0 && li.UserID != linkUserId {
+			return nil, errors.Conflict("provider", "identity already linked to another user")
+		}
+		return o.ur.GetUserByUserID(ctx, li.UserID)
+	}
+	if !errors.IsNotFound(err) {
+		return nil, err
+	}
+	link := func(u *User) (*User, error) {
+		err := o.ir.CreateIdentity(ctx, &LinkedIdentity{UserID: u.UserID, Provider: provider, Subject: claims.Subject, Email: claims.Email})
+		if err != nil {
+			return nil, err
+		}
+		return u, nil
+	}
+	if linkUserId != 0 {
+		u, err := o.ur.GetUserByUserID(ctx, linkUserId)
+		if err != nil {
+			return nil, err
+		}
+		ids, err := o.ir.ListIdentities(ctx, u.UserID)
+		if err != nil {
+			return nil, err
+		}
+		// 每个提供方只能绑定一个身份
+		for _, id := range ids {
+			if id.Provider == provider {
+				return nil, errors.Conflict("provider", "already linked, unlink it first")
+			}
+		}
+		return link(u)
+	}
+	if claims.Email == "" {
+		return nil, errors.ParamInvalid("email", "not provided by identity provider")
+	}
+	u, err := o.ur.GetUserByEmail(ctx, claims.Email)
+	if err == nil {
+		// 任一方未验证邮箱时自动绑定可能被用于抢占账号, 需登录后手动绑定
+		if !claims.EmailVerified || !u.EmailVerified {
+			return nil, errors.Conflict("email", "already registered, sign in and link the account instead")
+		}
+		return link(u)
+	}
+	if !errors.IsNotFound(err) {
+		return nil, err
+	}
+	username, err := o.uniqueUsername(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+	// 未设置密码, 只能通过第三方登录, 可使用重置密码设置
+	u = &User{Email: claims.Email, Username: username, Role: auth.RoleUser}
+	if len(claims.Picture) <= 128 {
+		u.Image = claims.Picture
+	}
+	if err := o.ur.CreateUser(ctx, u); err != nil {
+		return nil, err
+	}
+	if claims.EmailVerified {
+		if err := o.ur.MarkEmailVerified(ctx, u.UserID); err != nil {
+			return nil, err
+		}
+		u.EmailVerified = true
+	}
+	return link(u)
+}
+
+// 依次使用 preferred_username、name、邮箱前缀, 去掉用户名不允许的字符, 重复时追加数字
+func (o *OIDCUsecase) uniqueUsername(ctx context.Context, claims *oidc.Claims) (string, error) {
+	var base string
+	for _, s := range []string{claims.PreferredUsername, claims.Name, strings.SplitN(claims.Email, "@", 2)[0]} {
+		if base = sanitizeUsername(s); base != "" {
+			break
+		}
+	}
+	if base == "" {
+		base = "user"
+	}
+	if len(base) > 48 {
+		base = base[:48]
+	}
+	name := base
+	for i := 1; i <= maxUsernameAttempts; i++ {
+		_, err := o.ur.GetUserByUserName(ctx, name)
+		if errors.IsNotFound(err) {
+			return name, nil
+		}
+		if err != nil {
+			return "", err
+		}
+		name = base + strconv.Itoa(i)
+	}
+	return "", errors.Conflict("username", "has exist")
+}
+
+// 与注册接口的校验规则一致, 只允许字母、数字及 _ . -, 空格替换为 _
+func sanitizeUsername(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == '.', r == '-':
+			return r
+		case r == ' ':
+			return '_'
+		}
+		return -1
+	}, s)
+}
+
+// ListIdentities 当前用户绑定的第三方身份
+func (o *OIDCUsecase) ListIdentities(ctx context.Context) ([]*LinkedIdentity, error) {
+	loginUser, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("user", "not login")
+	}
+	return o.ir.ListIdentities(ctx, loginUser.UserID)
+}
+
+// Unlink 解除绑定, 不允许解除最后一种登录方式
+func (o *OIDCUsecase) Unlink(ctx context.Context, provider string) error {
+	loginUser, ok := auth.FromContext(ctx)
+	if !ok {
+		return errors.Unauthorized("user", "not login")
+	}
+	return o.tx.InTx(ctx, func(ctx context.Context) error {
+		u, err := o.ur.GetUserByUserID(ctx, loginUser.UserID)
+		if err != nil {
+			return err
+		}
+		ids, err := o.ir.ListIdentities(ctx, u.UserID)
+		if err != nil {
+			return err
+		}
+		if u.PasswdHash == "" && len(ids) <= 1 {
+			return errors.ParamInvalid("provider", "cannot unlink the only sign-in method, set a password first")
+		}
+		deleted, err := o.ir.DeleteIdentity(ctx, u.UserID, provider)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			return errors.NotFound("provider", "not linked")
+		}
+		return nil
+	})
+}
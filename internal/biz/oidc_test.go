@@ -0,0 +1,222 @@
+package biz
+
+import (
+	"context"
+	"demo/internal/conf"
+	"demo/internal/pkg/middleware/auth"
+	"demo/internal/pkg/oidc/oidctest"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 以 map 保存身份与 state 的 IdentityRepo
+type identityStub struct {
+	identities []*LinkedIdentity
+	states     map[string]*OIDCState
+}
+
+func (r *identityStub) GetIdentity(ctx context.Context, provider, subject string) (*LinkedIdentity, error) {
+	for _, li := range r.identities {
+		if li.Provider == provider && li.Subject == subject {
+			cp := *li
+			return &cp, nil
+		}
+	}
+	return nil, errors.NotFound("identity", "not found")
+}
+
+func (r *identityStub) ListIdentities(ctx context.Context, userId int) ([]*LinkedIdentity, error) {
+	ids := []*LinkedIdentity{}
+	for _, li := range r.identities {
+		if li.UserID == userId {
+			cp := *li
+			ids = append(ids, &cp)
+		}
+	}
+	return ids, nil
+}
+
+// 与 data 层的唯一索引一致
+func (r *identityStub) CreateIdentity(ctx context.Context, li *LinkedIdentity) error {
+	for _, v := range r.identities {
+		if (v.Provider == li.Provider && v.Subject == li.Subject) || (v.UserID == li.UserID && v.Provider == li.Provider) {
+			return errors.Conflict("identity", "duplicate")
+		}
+	}
+	li.ID = len(r.identities) + 1
+	cp := *li
+	r.identities = append(r.identities, &cp)
+	return nil
+}
+
+func (r *identityStub) DeleteIdentity(ctx context.Context, userId int, provider string) (bool, error) {
+	for i, li := range r.identities {
+		if li.UserID == userId && li.Provider == provider {
+			r.identities = append(r.identities[:i], r.identities[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *identityStub) CreateOIDCState(ctx context.Context, s *OIDCState) error {
+	cp := *s
+	r.states[s.State] = &cp
+	return nil
+}
+
+func (r *identityStub) GetOIDCState(ctx context.Context, state string) (*OIDCState, error) {
+	s, ok := r.states[state]
+	if !ok || time.Now().After(s.ExpiresAt) {
+		return nil, nil
+	}
+	cp := *s
+	return &cp, nil
+}
+
+func (r *identityStub) TakeOIDCState(ctx context.Context, state string) (*OIDCState, error) {
+	s, err := r.GetOIDCState(ctx, state)
+	delete(r.states, state)
+	return s, err
+}
+
+func TestOIDCLogin(t *testing.T) {
+	provider, err := oidctest.NewProvider("realworld", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer provider.Close()
+	key, _ := auth.NewHMACKey("", "HS256", []byte("secret"))
+	ks, _ := auth.NewKeySet(key)
+	ur := &userStub{users: map[int]*User{}}
+	ir := &identityStub{states: map[string]*OIDCState{}}
+	tu := NewTokenUsecase(&tokenStub{refresh: map[int]*RefreshToken{}, versions: map[int]int{}}, &sessionStub{sessions: map[int]*Session{}}, ur, txStub{}, ks, &conf.JWT{}, &conf.Mail{}, log.DefaultLogger)
+	au := NewAccountUsecase(ur, nil, nil, tu, txStub{}, nil, ks, &conf.Mail{}, nil, log.DefaultLogger)
+	ou, err := NewOIDCUsecase(&conf.OIDC{Providers: []*conf.OIDC_Provider{{
+		Name:         "test",
+		Issuer:       provider.URL,
+		ClientId:     "realworld",
+		ClientSecret: "secret",
+		RedirectUrl:  "http://localhost/oidc/callback",
+	}}}, ur, ir, tu, au, txStub{}, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	login := func(ctx context.Context, id oidctest.Identity) (*UserLogin, error) {
+		provider.SetIdentity(id)
+		authURL, err := ou.StartLogin(ctx, "test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		code, state, err := provider.Authorize(authURL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ul, err := ou.Callback(ctx, "test", code, state)
+		if err == nil {
+			// state 只能使用一次
+			if _, err := ou.Callback(ctx, "test", code, state); errors.Code(err) != 400 {
+				t.Fatalf("expected reused state to be rejected, got %v", err)
+			}
+		}
+		return ul, err
+	}
+	if _, err := ou.StartLogin(ctx, "unknown"); errors.Code(err) != 404 {
+		t.Fatalf("expected unknown provider to be rejected, got %v", err)
+	}
+
+	// 邮箱已注册但未验证时不自动绑定
+	owner := &User{Email: "a@b.c", Username: "a", PasswdHash: "x"}
+	if err := ur.CreateUser(ctx, owner); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := login(ctx, oidctest.Identity{Subject: "s1", Email: "a@b.c", EmailVerified: true}); errors.Code(err) != 409 {
+		t.Fatalf("expected conflict for unverified local email, got %v", err)
+	}
+	if err := ur.MarkEmailVerified(ctx, owner.UserID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := login(ctx, oidctest.Identity{Subject: "s1", Email: "a@b.c"}); errors.Code(err) != 409 {
+		t.Fatalf("expected conflict for unverified provider email, got %v", err)
+	}
+	ul, err := login(ctx, oidctest.Identity{Subject: "s1", Email: "a@b.c", EmailVerified: true})
+	if err != nil || ul.UserID != owner.UserID || ul.Token == "" {
+		t.Fatalf("expected login as linked user, got %+v %v", ul, err)
+	}
+
+	// 新邮箱创建用户, 用户名重复时追加数字
+	ul, err = login(ctx, oidctest.Identity{Subject: "s2", Email: "a@x.y", EmailVerified: true})
+	if err != nil || ul.UserID == owner.UserID || ul.Username != "a1" || !ul.EmailVerified {
+		t.Fatalf("expected new user, got %+v %v", ul, err)
+	}
+	created := auth.NewContext(ctx, auth.LoginUser{UserID: ul.UserID})
+	if err := ou.Unlink(created, "test"); errors.Code(err) != 422 {
+		t.Fatalf("expected unlinking the only sign-in method to fail, got %v", err)
+	}
+	if _, err := login(created, oidctest.Identity{Subject: "s1", Email: "a@b.c", EmailVerified: true}); errors.Code(err) != 409 {
+		t.Fatalf("expected identity linked to another user to be rejected, got %v", err)
+	}
+
+	// 已有密码的用户可以解除绑定, 之后登录发起时绑定新身份
+	ownerCtx := auth.NewContext(ctx, auth.LoginUser{UserID: owner.UserID})
+	if err := ou.Unlink(ownerCtx, "test"); err != nil {
+		t.Fatal(err)
+	}
+	if err := ou.Unlink(ownerCtx, "test"); errors.Code(err) != 404 {
+		t.Fatalf("expected not linked, got %v", err)
+	}
+	if ul, err = login(ownerCtx, oidctest.Identity{Subject: "s3", Email: "other@b.c"}); err != nil || ul.UserID != owner.UserID {
+		t.Fatalf("expected identity to be linked to current user, got %+v %v", ul, err)
+	}
+	ids, err := ou.ListIdentities(ownerCtx)
+	if err != nil || len(ids) != 1 || ids[0].Subject != "s3" {
+		t.Fatalf("unexpected identities: %+v %v", ids, err)
+	}
+
+	// 绑定流程的回调由匿名用户或其他用户完成时拒绝, 不绑定身份
+	for _, caller := range []context.Context{ctx, created} {
+		provider.SetIdentity(oidctest.Identity{Subject: "victim", Email: "victim@b.c", EmailVerified: true})
+		authURL, err := ou.StartLogin(ownerCtx, "test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		code, state, err := provider.Authorize(authURL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ou.Callback(caller, "test", code, state); errors.Code(err) != 403 {
+			t.Fatalf("expected link started by another user to be rejected, got %v", err)
+		}
+	}
+	if _, err := ir.GetIdentity(ctx, "test", "victim"); errors.Code(err) != 404 {
+		t.Fatalf("expected identity not to be linked, got %v", err)
+	}
+
+	// 被拒绝的回调不消耗 state, 发起绑定的用户仍可完成
+	if err := ou.Unlink(ownerCtx, "test"); err != nil {
+		t.Fatal(err)
+	}
+	provider.SetIdentity(oidctest.Identity{Subject: "s4", Email: "other@b.c"})
+	authURL, err := ou.StartLogin(ownerCtx, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, state, err := provider.Authorize(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ou.Callback(created, "test", code, state); errors.Code(err) != 403 {
+		t.Fatalf("expected link started by another user to be rejected, got %v", err)
+	}
+	if ul, err = ou.Callback(ownerCtx, "test", code, state); err != nil || ul.UserID != owner.UserID {
+		t.Fatalf("expected initiating user to complete the link, got %+v %v", ul, err)
+	}
+	if li, err := ir.GetIdentity(ctx, "test", "s4"); err != nil || li.UserID != owner.UserID {
+		t.Fatalf("expected identity to be linked to current user, got %+v %v", li, err)
+	}
+}
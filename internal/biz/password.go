@@ -0,0 +1,110 @@
+package biz
+
+import (
+	"bufio"
+	"demo/internal/conf"
+	"demo/internal/errors"
+	"demo/internal/pkg/password"
+	"fmt"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const defaultMinPasswordLength = 8
+
+// PasswordManager 密码哈希与密码策略
+type PasswordManager struct {
+	hasher    *password.Hasher
+	minLength int
+	// 已泄露的密码
+	breached map[string]struct{}
+	// 用户不存在或没有密码时用于校验的固定哈希, 使耗时与正常校验一致
+	dummyHash string
+	log       *log.Helper
+}
+
+func NewPasswordManager(c *conf.Password, logger log.Logger) (*PasswordManager, error) {
+	argon := password.Argon2idParams{
+		Time:    c.GetArgon2().GetTime(),
+		Memory:  c.GetArgon2().GetMemory(),
+		Threads: uint8(c.GetArgon2().GetThreads()),
+	}
+	hasher, err := password.NewHasher(c.GetAlgorithm(), argon, int(c.GetBcryptCost()))
+	if err != nil {
+		return nil, err
+	}
+	pm := &PasswordManager{
+		hasher:    hasher,
+		minLength: defaultMinPasswordLength,
+		breached:  map[string]struct{}{},
+		log:       log.NewHelper(logger),
+	}
+	if pm.dummyHash, err = hasher.Hash("realworld-dummy-password"); err != nil {
+		return nil, err
+	}
+	if n := c.GetMinLength(); n > 0 {
+		pm.minLength = int(n)
+	}
+	if f := c.GetBreachedListFile(); f != "" {
+		if pm.breached, err = loadBreachedList(f); err != nil {
+			return nil, err
+		}
+	}
+	return pm, nil
+}
+
+func loadBreachedList(name string) (map[string]struct{}, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("load breached password list: %w", err)
+	}
+	defer f.Close()
+	list := map[string]struct{}{}
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		list[line] = struct{}{}
+	}
+	return list, sc.Err()
+}
+
+// Validate 校验密码是否符合策略, 不符合时返回 422
+func (pm *PasswordManager) Validate(pwd string) error {
+	if utf8.RuneCountInString(pwd) < pm.minLength {
+		return errors.ParamInvalid("password", fmt.Sprintf("is too short (minimum is %d characters)", pm.minLength))
+	}
+	if _, ok := pm.breached[pwd]; ok {
+		return errors.ParamInvalid("password", "has appeared in a data breach, choose another one")
+	}
+	return nil
+}
+
+// Hash 生成密码哈希
+func (pm *PasswordManager) Hash(pwd string) (string, error) {
+	return pm.hasher.Hash(pwd)
+}
+
+// Verify 校验密码, 无法识别的哈希视为不匹配
+// hash 为空(用户不存在或通过第三方登录创建)时校验固定哈希后返回不匹配, 避免通过耗时判断账号是否存在
+func (pm *PasswordManager) Verify(hash, pwd string) bool {
+	if hash == "" {
+		pm.hasher.Verify(pm.dummyHash, pwd)
+		return false
+	}
+	ok, err := pm.hasher.Verify(hash, pwd)
+	if err != nil {
+		pm.log.Errorf("verify password: %v", err)
+	}
+	return ok
+}
+
+// NeedsRehash 哈希的算法或参数已过时
+func (pm *PasswordManager) NeedsRehash(hash string) bool {
+	return pm.hasher.NeedsRehash(hash)
+}
@@ -0,0 +1,68 @@
+package biz
+
+import (
+	"context"
+	"demo/internal/conf"
+	"demo/internal/pkg/middleware/auth"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 测试中使用较小的 argon2 参数
+func newTestPasswordManager(t *testing.T, c *conf.Password) *PasswordManager {
+	if c.Argon2 == nil {
+		c.Argon2 = &conf.Password_Argon2{Time: 1, Memory: 1024, Threads: 1}
+	}
+	pm, err := NewPasswordManager(c, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pm
+}
+
+func TestPasswordRehash(t *testing.T) {
+	breached := filepath.Join(t.TempDir(), "breached.txt")
+	if err := os.WriteFile(breached, []byte("# common\npassword123\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	key, _ := auth.NewHMACKey("", "HS256", []byte("secret"))
+	ks, _ := auth.NewKeySet(key)
+	pm := newTestPasswordManager(t, &conf.Password{MinLength: 10, BreachedListFile: breached})
+	ur := &userStub{users: map[int]*User{}}
+	tu := NewTokenUsecase(&tokenStub{refresh: map[int]*RefreshToken{}, versions: map[int]int{}}, &sessionStub{sessions: map[int]*Session{}}, ur, txStub{}, ks, &conf.JWT{}, &conf.Mail{}, log.DefaultLogger)
+	au := NewAccountUsecase(ur, nil, nil, tu, txStub{}, nil, ks, &conf.Mail{}, pm, log.DefaultLogger)
+	guard := NewLoginGuard(nil, &conf.LoginThrottle{Disabled: true}, log.DefaultLogger)
+	uc := NewUserUseCase(ur, nil, tu, au, guard, pm, log.DefaultLogger)
+	ctx := context.Background()
+
+	if _, err := uc.Register(ctx, "a", "a@b.c", "short"); errors.Code(err) != 422 {
+		t.Fatalf("expected short password to be rejected, got %v", err)
+	}
+	if _, err := uc.Register(ctx, "a", "a@b.c", "password123"); errors.Code(err) != 422 {
+		t.Fatalf("expected breached password to be rejected, got %v", err)
+	}
+	// 旧的 bcrypt 哈希在登录成功后升级为 argon2id
+	legacy, _ := bcrypt.GenerateFromPassword([]byte("legacy-password"), bcrypt.MinCost)
+	ur.users[1] = &User{UserID: 1, Email: "a@b.c", Username: "a", PasswdHash: string(legacy)}
+	// 账号不存在与密码错误的返回一致
+	_, unknown := uc.Login(ctx, "x@b.c", "legacy-password", "127.0.0.1")
+	_, wrong := uc.Login(ctx, "a@b.c", "wrong-password", "127.0.0.1")
+	if se := errors.FromError(unknown); se.Code != 403 || se.Error() != errors.FromError(wrong).Error() {
+		t.Fatalf("expected unknown account to look like a wrong password, got %v and %v", unknown, wrong)
+	}
+	if _, err := uc.Login(ctx, "a@b.c", "legacy-password", "127.0.0.1"); err != nil {
+		t.Fatal(err)
+	}
+	if h := ur.users[1].PasswdHash; !strings.HasPrefix(h, "$argon2id$") || pm.NeedsRehash(h) {
+		t.Fatalf("expected argon2id hash after login, got %s", h)
+	}
+	if _, err := uc.Login(ctx, "a@b.c", "legacy-password", "127.0.0.1"); err != nil {
+		t.Fatal(err)
+	}
+}
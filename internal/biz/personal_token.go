@@ -0,0 +1,176 @@
+package biz
+
+import (
+	"context"
+	"demo/internal/errors"
+	"demo/internal/pkg/middleware/auth"
+	"sort"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	maxPersonalTokens        = 50
+	maxPersonalTokenNameLen  = 64
+	personalTokenDisplayLen  = len(auth.PersonalTokenPrefix) + 4
+	personalTokenTouchPeriod = time.Minute
+)
+
+// PersonalToken 个人访问令牌, 只保存哈希
+type PersonalToken struct {
+	ID        int
+	UserID    int
+	Name      string
+	TokenHash string
+	// 令牌开头几位, 用于在列表中辨认
+	Prefix    string
+	Scopes    []string
+	CreatedAt time.Time
+	// 零值表示永不过期
+	ExpiresAt  time.Time
+	LastUsedAt time.Time
+}
+
+type PersonalTokenRepo interface {
+	CreatePersonalToken(ctx context.Context, t *PersonalToken) error
+	ListPersonalTokens(ctx context.Context, userId int) ([]*PersonalToken, error)
+	// 不存在时返回 404
+	GetPersonalTokenByHash(ctx context.Context, hash string) (*PersonalToken, error)
+	// 返回是否存在并已删除
+	DeletePersonalToken(ctx context.Context, userId, id int) (bool, error)
+	TouchPersonalToken(ctx context.Context, id int, t time.Time) error
+	// 删除用户全部令牌
+	DeleteUserPersonalTokens(ctx context.Context, userId int) error
+}
+
+// PersonalTokenUsecase 个人访问令牌, 供脚本与集成调用接口
+type PersonalTokenUsecase struct {
+	ptr PersonalTokenRepo
+	ur  UserRepo
+	log *log.Helper
+}
+
+func NewPersonalTokenUsecase(ptr PersonalTokenRepo, ur UserRepo, logger log.Logger) *PersonalTokenUsecase {
+	return &PersonalTokenUsecase{ptr: ptr, ur: ur, log: log.NewHelper(logger)}
+}
+
+// Create 创建令牌, 返回的明文令牌只在此时可见; ttl 为 0 时永不过期
+func (pu *PersonalTokenUsecase) Create(ctx context.Context, name string, scopes []string, ttl time.Duration) (*PersonalToken, string, error) {
+	loginUser, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, "", errors.Unauthorized("user", "not login")
+	}
+	if name == "" || utf8.RuneCountInString(name) > maxPersonalTokenNameLen {
+		return nil, "", errors.ParamInvalid("name", "must be 1 to 64 characters")
+	}
+	if ttl < 0 {
+		return nil, "", errors.ParamInvalid("expires_in_days", "cannot be negative")
+	}
+	if len(scopes) == 0 {
+		return nil, "", errors.ParamInvalid("scopes", "cannot empty")
+	}
+	set := map[string]struct{}{}
+	for _, s := range scopes {
+		if !auth.ValidScope(s) {
+			return nil, "", errors.ParamInvalid("scopes", "unknown scope "+s)
+		}
+		set[s] = struct{}{}
+	}
+	scopes = make([]string, 0, len(set))
+	for s := range set {
+		scopes = append(scopes, s)
+	}
+	sort.Strings(scopes)
+	existing, err := pu.ptr.ListPersonalTokens(ctx, loginUser.UserID)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(existing) >= maxPersonalTokens {
+		return nil, "", errors.ParamInvalid("name", "too many tokens, revoke unused ones first")
+	}
+	for _, t := range existing {
+		if t.Name == name {
+			return nil, "", errors.ParamInvalid("name", "has exist")
+		}
+	}
+	token := auth.PersonalTokenPrefix + newRefreshToken()
+	t := &PersonalToken{
+		UserID:    loginUser.UserID,
+		Name:      name,
+		TokenHash: hashToken(token),
+		Prefix:    token[:personalTokenDisplayLen],
+		Scopes:    scopes,
+	}
+	if ttl > 0 {
+		t.ExpiresAt = time.Now().Add(ttl)
+	}
+	if err := pu.ptr.CreatePersonalToken(ctx, t); err != nil {
+		return nil, "", err
+	}
+	return t, token, nil
+}
+
+// List 当前用户的令牌
+func (pu *PersonalTokenUsecase) List(ctx context.Context) ([]*PersonalToken, error) {
+	loginUser, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("user", "not login")
+	}
+	return pu.ptr.ListPersonalTokens(ctx, loginUser.UserID)
+}
+
+// Revoke 吊销当前用户的令牌
+func (pu *PersonalTokenUsecase) Revoke(ctx context.Context, id int) error {
+	loginUser, ok := auth.FromContext(ctx)
+	if !ok {
+		return errors.Unauthorized("user", "not login")
+	}
+	deleted, err := pu.ptr.DeletePersonalToken(ctx, loginUser.UserID, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return errors.NotFound("token", "not found")
+	}
+	return nil
+}
+
+// AuthenticatePersonalToken 实现 auth.PersonalTokenAuthenticator, 角色等用户信息按当前状态返回
+func (pu *PersonalTokenUsecase) AuthenticatePersonalToken(ctx context.Context, token string) (auth.LoginUser, error) {
+	invalid := errors.Unauthorized("token", "invalid token")
+	t, err := pu.ptr.GetPersonalTokenByHash(ctx, hashToken(token))
+	if errors.IsNotFound(err) {
+		return auth.LoginUser{}, invalid
+	}
+	if err != nil {
+		return auth.LoginUser{}, err
+	}
+	now := time.Now()
+	if !t.ExpiresAt.IsZero() && now.After(t.ExpiresAt) {
+		return auth.LoginUser{}, errors.Unauthorized("token", "token has expired")
+	}
+	u, err := pu.ur.GetUserByUserID(ctx, t.UserID)
+	if errors.IsNotFound(err) {
+		return auth.LoginUser{}, invalid
+	}
+	if err != nil {
+		return auth.LoginUser{}, err
+	}
+	// 降低写入频率, 最近使用时间精确到分钟
+	if now.Sub(t.LastUsedAt) > personalTokenTouchPeriod {
+		if err := pu.ptr.TouchPersonalToken(ctx, t.ID, now); err != nil {
+			pu.log.Errorf("touch personal token: %v", err)
+		}
+	}
+	return auth.LoginUser{
+		Email:          u.Email,
+		Username:       u.Username,
+		UserID:         u.UserID,
+		Role:           u.Role,
+		TokenExpiresAt: t.ExpiresAt,
+		PersonalToken:  true,
+		Scopes:         t.Scopes,
+	}, nil
+}
@@ -0,0 +1,160 @@
+package biz
+
+import (
+	"context"
+	"demo/internal/conf"
+	"demo/internal/pkg/middleware/auth"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 以 map 保存令牌的 PersonalTokenRepo
+type personalTokenStub struct {
+	PersonalTokenRepo
+	tokens map[int]*PersonalToken
+	lastID int
+}
+
+func (r *personalTokenStub) CreatePersonalToken(ctx context.Context, pt *PersonalToken) error {
+	r.lastID++
+	pt.ID = r.lastID
+	cp := *pt
+	r.tokens[pt.ID] = &cp
+	return nil
+}
+
+func (r *personalTokenStub) ListPersonalTokens(ctx context.Context, userId int) ([]*PersonalToken, error) {
+	ts := []*PersonalToken{}
+	for _, pt := range r.tokens {
+		if pt.UserID == userId {
+			cp := *pt
+			ts = append(ts, &cp)
+		}
+	}
+	return ts, nil
+}
+
+func (r *personalTokenStub) GetPersonalTokenByHash(ctx context.Context, hash string) (*PersonalToken, error) {
+	for _, pt := range r.tokens {
+		if pt.TokenHash == hash {
+			cp := *pt
+			return &cp, nil
+		}
+	}
+	return nil, errors.NotFound("token", "not found")
+}
+
+func (r *personalTokenStub) DeletePersonalToken(ctx context.Context, userId, id int) (bool, error) {
+	if pt, ok := r.tokens[id]; !ok || pt.UserID != userId {
+		return false, nil
+	}
+	delete(r.tokens, id)
+	return true, nil
+}
+
+func (r *personalTokenStub) TouchPersonalToken(ctx context.Context, id int, t time.Time) error {
+	if pt, ok := r.tokens[id]; ok {
+		pt.LastUsedAt = t
+	}
+	return nil
+}
+
+func (r *personalTokenStub) DeleteUserPersonalTokens(ctx context.Context, userId int) error {
+	for id, pt := range r.tokens {
+		if pt.UserID == userId {
+			delete(r.tokens, id)
+		}
+	}
+	return nil
+}
+
+func TestPersonalToken(t *testing.T) {
+	ptr := &personalTokenStub{tokens: map[int]*PersonalToken{}}
+	ur := &userStub{users: map[int]*User{1: {UserID: 1, Email: "a@b.c", Username: "a", Role: auth.RoleUser}}}
+	pu := NewPersonalTokenUsecase(ptr, ur, log.DefaultLogger)
+	ctx := context.Background()
+	userCtx := auth.NewContext(ctx, auth.LoginUser{UserID: 1})
+
+	if _, _, err := pu.Create(userCtx, "ci", []string{"articles:delete"}, 0); errors.Code(err) != 422 {
+		t.Fatalf("expected unknown scope to be rejected, got %v", err)
+	}
+	pt, token, err := pu.Create(userCtx, "ci", []string{auth.ScopeArticlesWrite, auth.ScopeArticlesWrite}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(token, auth.PersonalTokenPrefix) || !strings.HasPrefix(token, pt.Prefix) || len(pt.Scopes) != 1 {
+		t.Fatalf("unexpected token: %s %+v", token, pt)
+	}
+	if _, _, err := pu.Create(userCtx, "ci", []string{auth.ScopeProfileRead}, 0); errors.Code(err) != 422 {
+		t.Fatalf("expected duplicate name to be rejected, got %v", err)
+	}
+	lu, err := pu.AuthenticatePersonalToken(ctx, token)
+	if err != nil || lu.UserID != 1 || !lu.PersonalToken || !lu.HasScope(auth.ScopeArticlesWrite) || lu.HasScope(auth.ScopeProfileRead) {
+		t.Fatalf("unexpected login user: %+v %v", lu, err)
+	}
+	ts, err := pu.List(userCtx)
+	if err != nil || len(ts) != 1 || ts[0].LastUsedAt.IsZero() {
+		t.Fatalf("expected last used time to be recorded, got %+v %v", ts, err)
+	}
+
+	// 过期的令牌
+	old, expired, err := pu.Create(userCtx, "old", []string{auth.ScopeProfileRead}, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ptr.tokens[old.ID].ExpiresAt = time.Now().Add(-time.Minute)
+	if _, err := pu.AuthenticatePersonalToken(ctx, expired); errors.Code(err) != 401 {
+		t.Fatalf("expected expired token to be rejected, got %v", err)
+	}
+
+	other := auth.NewContext(ctx, auth.LoginUser{UserID: 2})
+	if err := pu.Revoke(other, pt.ID); errors.Code(err) != 404 {
+		t.Fatalf("expected other user's token to be hidden, got %v", err)
+	}
+	if err := pu.Revoke(userCtx, pt.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pu.AuthenticatePersonalToken(ctx, token); errors.Code(err) != 401 {
+		t.Fatalf("expected revoked token to be rejected, got %v", err)
+	}
+}
+
+// 退出所有设备保留个人访问令牌, 重置密码时删除
+func TestPersonalTokenLifetime(t *testing.T) {
+	key, _ := auth.NewHMACKey("", "HS256", []byte("secret"))
+	ks, _ := auth.NewKeySet(key)
+	mc := &conf.Mail{BaseUrl: "http://localhost"}
+	ptr := &personalTokenStub{tokens: map[int]*PersonalToken{}}
+	ur := &userStub{users: map[int]*User{1: {UserID: 1, Email: "a@b.c", Username: "a", Role: auth.RoleUser}}}
+	mailer := &mailStub{}
+	tu := NewTokenUsecase(&tokenStub{refresh: map[int]*RefreshToken{}, versions: map[int]int{}}, &sessionStub{sessions: map[int]*Session{}}, ur, txStub{}, ks, &conf.JWT{}, mc, log.DefaultLogger)
+	au := NewAccountUsecase(ur, &actionTokenStub{tokens: map[string]*ActionToken{}}, ptr, tu, txStub{}, mailer, ks, mc, newTestPasswordManager(t, &conf.Password{}), log.DefaultLogger)
+	pu := NewPersonalTokenUsecase(ptr, ur, log.DefaultLogger)
+	ctx := context.Background()
+	userCtx := auth.NewContext(ctx, auth.LoginUser{UserID: 1})
+	_, token, err := pu.Create(userCtx, "ci", []string{auth.ScopeArticlesWrite}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := tu.LogoutAll(userCtx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pu.AuthenticatePersonalToken(ctx, token); err != nil {
+		t.Fatalf("expected token to survive logout all, got %v", err)
+	}
+
+	if err := au.RequestPasswordReset(ctx, "a@b.c"); err != nil {
+		t.Fatal(err)
+	}
+	if err := au.ConfirmPasswordReset(ctx, mailer.token(), "new-password"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pu.AuthenticatePersonalToken(ctx, token); errors.Code(err) != 401 {
+		t.Fatalf("expected token to be deleted by password reset, got %v", err)
+	}
+}
@@ -1,5 +1,8 @@
 package biz
 
+// 默认分页大小
+const DefaultListLimit = 20
+
 type ListOption func(*ListOptions)
 
 type ListOptions struct {
@@ -26,3 +29,18 @@ func ListLimit(limit int64) ListOption {
 		o.Limit = limit
 	}
 }
+
+// NewListOptions 应用查询选项, limit 未设置时使用默认分页大小
+func NewListOptions(opt ...ListOption) *ListOptions {
+	o := &ListOptions{Limit: DefaultListLimit}
+	for _, v := range opt {
+		v(o)
+	}
+	if o.Limit <= 0 {
+		o.Limit = DefaultListLimit
+	}
+	if o.Offset < 0 {
+		o.Offset = 0
+	}
+	return o
+}
@@ -0,0 +1,205 @@
+package biz
+
+import (
+	"context"
+	"demo/internal/errors"
+	"demo/internal/pkg/middleware/auth"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	maxUserAgentLen = 255
+	// 最近活跃时间的更新间隔, 降低写入频率
+	sessionTouchPeriod = time.Minute
+)
+
+// ClientInfo 发起请求的客户端, 由 service 层写入 context, 签发 token 时记录到会话
+type ClientInfo struct {
+	IP        string
+	UserAgent string
+}
+
+type clientInfoKey struct{}
+
+func NewClientContext(ctx context.Context, ci ClientInfo) context.Context {
+	return context.WithValue(ctx, clientInfoKey{}, ci)
+}
+
+func clientFromContext(ctx context.Context) ClientInfo {
+	ci, _ := ctx.Value(clientInfoKey{}).(ClientInfo)
+	return ci
+}
+
+// Session 一次登录产生的会话, 刷新 token 时沿用, access token 通过 sid 关联
+type Session struct {
+	ID         int
+	UserID     int
+	UserAgent  string
+	Device     string
+	IP         string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	ExpiresAt  time.Time
+	RevokedAt  time.Time
+	// 是否为当前请求所在的会话
+	Current bool
+}
+
+// Active 未吊销且未过期
+func (s *Session) Active(now time.Time) bool {
+	return s.RevokedAt.IsZero() && now.Before(s.ExpiresAt)
+}
+
+type SessionRepo interface {
+	CreateSession(ctx context.Context, s *Session) error
+	// 不存在时返回 404
+	GetSession(ctx context.Context, id int) (*Session, error)
+	// 未吊销且未过期的会话, 按最近活跃时间倒序
+	ListActiveSessions(ctx context.Context, userId int) ([]*Session, error)
+	TouchSession(ctx context.Context, id int, seenAt time.Time) error
+	// 刷新 token 时更新 IP 并延长有效期
+	RefreshSession(ctx context.Context, id int, ci ClientInfo, seenAt, expiresAt time.Time) error
+	// 返回是否由本次调用吊销
+	RevokeSession(ctx context.Context, userId, id int) (bool, error)
+	// 吊销用户的全部会话, exceptId 不为 0 时保留该会话
+	RevokeUserSessions(ctx context.Context, userId, exceptId int) error
+}
+
+var (
+	deviceOS = []struct{ keyword, name string }{
+		{"Windows", "Windows"},
+		{"iPhone", "iOS"},
+		{"iPad", "iPadOS"},
+		{"Android", "Android"},
+		{"Mac OS X", "macOS"},
+		{"CrOS", "ChromeOS"},
+		{"Linux", "Linux"},
+	}
+	// 顺序有关, 如 Edge 的 User-Agent 同时包含 Chrome 与 Safari
+	deviceBrowsers = []struct{ keyword, name string }{
+		{"Edg/", "Edge"},
+		{"OPR/", "Opera"},
+		{"Firefox/", "Firefox"},
+		{"Chrome/", "Chrome"},
+		{"Safari/", "Safari"},
+	}
+)
+
+// 根据 User-Agent 生成便于辨认的设备描述, 如 Chrome on macOS; 无法识别时取产品名, 如 curl
+func deviceName(ua string) string {
+	var browser, system string
+	for _, b := range deviceBrowsers {
+		if strings.Contains(ua, b.keyword) {
+			browser = b.name
+			break
+		}
+	}
+	for _, o := range deviceOS {
+		if strings.Contains(ua, o.keyword) {
+			system = o.name
+			break
+		}
+	}
+	switch {
+	case browser != "" && system != "":
+		return browser + " on " + system
+	case browser != "":
+		return browser
+	case system != "":
+		return system
+	}
+	if i := strings.IndexAny(ua, "/ "); i > 0 {
+		return ua[:i]
+	}
+	if ua == "" {
+		return "Unknown"
+	}
+	return ua
+}
+
+// 创建会话, 记录发起登录的客户端
+func (tu *TokenUsecase) newSession(ctx context.Context, u *User) (*Session, error) {
+	ci := clientFromContext(ctx)
+	// 按字节截断到列长度, 从字符边界处截断, 避免写入不完整的 UTF-8 字符
+	if len(ci.UserAgent) > maxUserAgentLen {
+		n := maxUserAgentLen
+		for n > 0 && !utf8.RuneStart(ci.UserAgent[n]) {
+			n--
+		}
+		ci.UserAgent = ci.UserAgent[:n]
+	}
+	now := time.Now()
+	s := &Session{
+		UserID:     u.UserID,
+		UserAgent:  ci.UserAgent,
+		Device:     deviceName(ci.UserAgent),
+		IP:         ci.IP,
+		LastSeenAt: now,
+		ExpiresAt:  now.Add(tu.refreshTTL()),
+	}
+	if err := tu.sr.CreateSession(ctx, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// 校验 access token 所属会话是否有效, 并记录最近活跃时间
+func (tu *TokenUsecase) checkSession(ctx context.Context, s *Session) bool {
+	now := time.Now()
+	if s == nil || !s.Active(now) {
+		return false
+	}
+	if now.Sub(s.LastSeenAt) > sessionTouchPeriod {
+		if err := tu.sr.TouchSession(ctx, s.ID, now); err != nil {
+			tu.log.Errorf("touch session: %v", err)
+		}
+	}
+	return true
+}
+
+// ListSessions 当前用户的活跃会话
+func (tu *TokenUsecase) ListSessions(ctx context.Context) ([]*Session, error) {
+	loginUser, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("user", "not login")
+	}
+	ss, err := tu.sr.ListActiveSessions(ctx, loginUser.UserID)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range ss {
+		s.Current = s.ID == loginUser.SessionID
+	}
+	return ss, nil
+}
+
+// RevokeSession 退出指定会话, 该会话的 access token 与 refresh token 随即失效
+func (tu *TokenUsecase) RevokeSession(ctx context.Context, id int) error {
+	loginUser, ok := auth.FromContext(ctx)
+	if !ok {
+		return errors.Unauthorized("user", "not login")
+	}
+	revoked, err := tu.sr.RevokeSession(ctx, loginUser.UserID, id)
+	if err != nil {
+		return err
+	}
+	if !revoked {
+		return errors.NotFound("session", "not found")
+	}
+	return nil
+}
+
+// RevokeOtherSessions 退出当前会话以外的全部会话
+func (tu *TokenUsecase) RevokeOtherSessions(ctx context.Context) error {
+	loginUser, ok := auth.FromContext(ctx)
+	if !ok {
+		return errors.Unauthorized("user", "not login")
+	}
+	// 会话功能上线前签发的 token 不属于任何会话
+	if loginUser.SessionID == 0 {
+		return errors.ParamInvalid("session", "current session unknown, please login again")
+	}
+	return tu.sr.RevokeUserSessions(ctx, loginUser.UserID, loginUser.SessionID)
+}
@@ -0,0 +1,184 @@
+package biz
+
+import (
+	"context"
+	"demo/internal/conf"
+	"demo/internal/pkg/middleware/auth"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 以 map 保存会话的 SessionRepo
+type sessionStub struct {
+	SessionRepo
+	sessions map[int]*Session
+}
+
+func (r *sessionStub) CreateSession(ctx context.Context, s *Session) error {
+	s.ID = len(r.sessions) + 1
+	cp := *s
+	r.sessions[s.ID] = &cp
+	return nil
+}
+
+func (r *sessionStub) GetSession(ctx context.Context, id int) (*Session, error) {
+	s, ok := r.sessions[id]
+	if !ok {
+		return nil, errors.NotFound("session", "not found")
+	}
+	cp := *s
+	return &cp, nil
+}
+
+func (r *sessionStub) ListActiveSessions(ctx context.Context, userId int) ([]*Session, error) {
+	ss := []*Session{}
+	now := time.Now()
+	for _, s := range r.sessions {
+		if s.UserID == userId && s.Active(now) {
+			cp := *s
+			ss = append(ss, &cp)
+		}
+	}
+	sort.Slice(ss, func(i, j int) bool { return ss[i].LastSeenAt.After(ss[j].LastSeenAt) })
+	return ss, nil
+}
+
+func (r *sessionStub) TouchSession(ctx context.Context, id int, seenAt time.Time) error {
+	if s, ok := r.sessions[id]; ok {
+		s.LastSeenAt = seenAt
+	}
+	return nil
+}
+
+func (r *sessionStub) RefreshSession(ctx context.Context, id int, ci ClientInfo, seenAt, expiresAt time.Time) error {
+	if s, ok := r.sessions[id]; ok {
+		s.LastSeenAt, s.ExpiresAt = seenAt, expiresAt
+	}
+	return nil
+}
+
+func (r *sessionStub) RevokeSession(ctx context.Context, userId, id int) (bool, error) {
+	s, ok := r.sessions[id]
+	if !ok || s.UserID != userId || !s.RevokedAt.IsZero() {
+		return false, nil
+	}
+	s.RevokedAt = time.Now()
+	return true, nil
+}
+
+func (r *sessionStub) RevokeUserSessions(ctx context.Context, userId, exceptId int) error {
+	for _, s := range r.sessions {
+		if s.UserID == userId && s.ID != exceptId && s.RevokedAt.IsZero() {
+			s.RevokedAt = time.Now()
+		}
+	}
+	return nil
+}
+
+// 会话状态从 sessionStub 读取的 TokenRepo
+type sessionTokenStub struct {
+	*tokenStub
+	sr *sessionStub
+}
+
+func (r sessionTokenStub) GetTokenState(ctx context.Context, userId int, jti string, sessionId int) (*TokenState, error) {
+	st := &TokenState{Version: r.versions[userId]}
+	if s, ok := r.sr.sessions[sessionId]; ok && s.UserID == userId {
+		cp := *s
+		st.Session = &cp
+	}
+	return st, nil
+}
+
+func TestSessions(t *testing.T) {
+	key, _ := auth.NewHMACKey("", "HS256", []byte("secret"))
+	ks, _ := auth.NewKeySet(key)
+	u := &User{UserID: 1, Email: "a@b.c", Username: "a", Role: auth.RoleUser}
+	ur := &userStub{users: map[int]*User{1: u}}
+	sr := &sessionStub{sessions: map[int]*Session{}}
+	tr := sessionTokenStub{tokenStub: &tokenStub{refresh: map[int]*RefreshToken{}, versions: map[int]int{}}, sr: sr}
+	tu := NewTokenUsecase(tr, sr, ur, txStub{}, ks, &conf.JWT{}, &conf.Mail{}, log.DefaultLogger)
+	ctx := context.Background()
+	browser := NewClientContext(ctx, ClientInfo{IP: "10.0.0.1", UserAgent: "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0 Safari/537.36"})
+	if _, err := tu.Issue(browser, u); err != nil {
+		t.Fatal(err)
+	}
+	cli, err := tu.Issue(NewClientContext(ctx, ClientInfo{IP: "10.0.0.2", UserAgent: "curl/8.0"}), u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ss, err := tu.ListSessions(auth.NewContext(ctx, auth.LoginUser{UserID: u.UserID}))
+	if err != nil || len(ss) != 2 {
+		t.Fatalf("expected 2 sessions, got %+v %v", ss, err)
+	}
+	devices := map[string]*Session{}
+	for _, s := range ss {
+		devices[s.Device] = s
+	}
+	current, other := devices["Chrome on macOS"], devices["curl"]
+	if current == nil || other == nil || current.IP != "10.0.0.1" {
+		t.Fatalf("unexpected sessions: %+v %+v", ss[0], ss[1])
+	}
+	userCtx := auth.NewContext(ctx, auth.LoginUser{UserID: u.UserID, SessionID: current.ID})
+	if ss, _ := tu.ListSessions(userCtx); !ss[0].Current && !ss[1].Current {
+		t.Fatal("expected current session to be marked")
+	}
+
+	if err := tu.RevokeSession(auth.NewContext(ctx, auth.LoginUser{UserID: u.UserID + 1}), other.ID); errors.Code(err) != 404 {
+		t.Fatalf("expected other user's session to be hidden, got %v", err)
+	}
+	if err := tu.RevokeSession(userCtx, other.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := tu.RevokeSession(userCtx, other.ID); errors.Code(err) != 404 {
+		t.Fatalf("expected revoked session to be gone, got %v", err)
+	}
+	if revoked, err := tu.IsRevoked(ctx, &auth.CustomClaims{LoginUser: auth.LoginUser{UserID: u.UserID, SessionID: other.ID}}); err != nil || !revoked {
+		t.Fatalf("expected access token of revoked session to be rejected, got %v %v", revoked, err)
+	}
+	if revoked, err := tu.IsRevoked(ctx, &auth.CustomClaims{LoginUser: auth.LoginUser{UserID: u.UserID, SessionID: current.ID}}); err != nil || revoked {
+		t.Fatalf("expected current session to stay valid, got %v %v", revoked, err)
+	}
+	if _, _, err := tu.Refresh(ctx, cli.RefreshToken); errors.Code(err) != 401 {
+		t.Fatalf("expected refresh token of revoked session to be rejected, got %v", err)
+	}
+
+	// 退出其他会话
+	if _, err := tu.Issue(ctx, u); err != nil {
+		t.Fatal(err)
+	}
+	if err := tu.RevokeOtherSessions(auth.NewContext(ctx, auth.LoginUser{UserID: u.UserID})); errors.Code(err) != 422 {
+		t.Fatalf("expected unknown current session to be rejected, got %v", err)
+	}
+	if err := tu.RevokeOtherSessions(userCtx); err != nil {
+		t.Fatal(err)
+	}
+	ss, err = tu.ListSessions(userCtx)
+	if err != nil || len(ss) != 1 || ss[0].ID != current.ID || !ss[0].Current {
+		t.Fatalf("expected only current session to remain, got %+v %v", ss, err)
+	}
+}
+
+// 过长的 User-Agent 按字节截断时不拆开多字节字符
+func TestSessionUserAgentTruncation(t *testing.T) {
+	key, _ := auth.NewHMACKey("", "HS256", []byte("secret"))
+	ks, _ := auth.NewKeySet(key)
+	u := &User{UserID: 1, Email: "a@b.c", Username: "a", Role: auth.RoleUser}
+	sr := &sessionStub{sessions: map[int]*Session{}}
+	tu := NewTokenUsecase(&tokenStub{refresh: map[int]*RefreshToken{}, versions: map[int]int{}}, sr, &userStub{users: map[int]*User{1: u}}, txStub{}, ks, &conf.JWT{}, &conf.Mail{}, log.DefaultLogger)
+	// "a" 之后每个字符 3 字节, 第 255 字节落在字符中间
+	ua := "a" + strings.Repeat("浏览器", 100)
+	if _, err := tu.Issue(NewClientContext(context.Background(), ClientInfo{UserAgent: ua}), u); err != nil {
+		t.Fatal(err)
+	}
+	got := sr.sessions[1].UserAgent
+	if !utf8.ValidString(got) || len(got) != 253 || !strings.HasPrefix(ua, got) {
+		t.Fatalf("expected user agent truncated to 253 bytes on a rune boundary, got %d bytes %q", len(got), got)
+	}
+}
@@ -0,0 +1,110 @@
+package biz
+
+import (
+	"context"
+	"demo/internal/errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/mozillazg/go-pinyin"
+)
+
+// slug 最大长度(不含去重后缀)
+const maxSlugLength = 96
+
+// 与文章路由冲突的保留 slug
+var reservedSlugs = map[string]struct{}{
+	"feed": {},
+	"id":   {},
+}
+
+// Transliterator 将标题转写为 ASCII 文本, 生成 slug 前调用
+type Transliterator func(s string) string
+
+// PinyinTransliterator 将汉字转写为不带声调的拼音, 其它字符原样保留
+func PinyinTransliterator(s string) string {
+	args := pinyin.NewArgs()
+	var b strings.Builder
+	for _, r := range s {
+		if !unicode.Is(unicode.Han, r) {
+			b.WriteRune(r)
+			continue
+		}
+		if py := pinyin.SinglePinyin(r, args); len(py) > 0 {
+			b.WriteString(" " + py[0] + " ")
+		}
+	}
+	return b.String()
+}
+
+// Slugify 根据标题生成 slug, 仅包含小写字母、数字和连字符
+func Slugify(title string, tr Transliterator) string {
+	if tr != nil {
+		title = tr(title)
+	}
+	var b strings.Builder
+	sep := false
+	for _, r := range strings.ToLower(title) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if sep && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			sep = false
+			continue
+		}
+		sep = true
+	}
+	slug := b.String()
+	if len(slug) > maxSlugLength {
+		slug = strings.TrimRight(slug[:maxSlugLength], "-")
+	}
+	if slug == "" {
+		return "article"
+	}
+	// 纯数字 slug 会与文章ID混淆
+	if _, err := strconv.Atoi(slug); err == nil {
+		return "article-" + slug
+	}
+	return slug
+}
+
+// 生成未被占用的 slug, 冲突时追加数字后缀; 需与写入 slug 在同一事务中调用,
+// 并发写入同一 slug 时由唯一索引拒绝, repo 返回 409
+func (s *SocialUsecase) uniqueSlug(ctx context.Context, title string, articleId int) (string, error) {
+	base := Slugify(title, s.translit)
+	for i := 1; i <= 100; i++ {
+		slug := base
+		if i > 1 {
+			slug = fmt.Sprintf("%s-%d", base, i)
+		}
+		if _, ok := reservedSlugs[slug]; ok {
+			continue
+		}
+		exist, err := s.ar.SlugExists(ctx, slug, articleId)
+		if err != nil {
+			return "", err
+		}
+		if !exist {
+			return slug, nil
+		}
+	}
+	return "", errors.ParamInvalid("slug", "too many articles with the same title")
+}
+
+// ResolveSlug 根据 slug 查找文章ID, 支持旧 slug 与纯数字文章ID
+func (s *SocialUsecase) ResolveSlug(ctx context.Context, slug string) (int, error) {
+	ar, err := s.ar.GetBySlug(ctx, slug)
+	if err == nil {
+		return ar.ID, nil
+	}
+	if !errors.IsNotFound(err) {
+		return 0, err
+	}
+	if id, perr := strconv.Atoi(slug); perr == nil {
+		return id, nil
+	}
+	return 0, err
+}
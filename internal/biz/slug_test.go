@@ -0,0 +1,24 @@
+package biz
+
+import (
+	"testing"
+)
+
+func TestSlugify(t *testing.T) {
+	cases := []struct {
+		title string
+		want  string
+	}{
+		{"How to train your dragon", "how-to-train-your-dragon"},
+		{"  Hello, World!  ", "hello-world"},
+		{"Go 语言入门", "go-yu-yan-ru-men"},
+		{"你好世界", "ni-hao-shi-jie"},
+		{"2022", "article-2022"},
+		{"!!!", "article"},
+	}
+	for _, c := range cases {
+		if got := Slugify(c.title, PinyinTransliterator); got != c.want {
+			t.Errorf("Slugify(%q) = %q, want %q", c.title, got, c.want)
+		}
+	}
+}
@@ -2,6 +2,8 @@ package biz
 
 import (
 	"context"
+	"demo/internal/errors"
+	"demo/internal/pkg/middleware/auth"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -20,6 +22,8 @@ type Article struct {
 	Favorited      bool      `json:"favorited"`
 	FavoritesCount int       `json:"favoritesCount"`
 	Author         Author    `json:"author"`
+	// 删除时间, 未删除时为零值
+	DeletedAt time.Time `json:"-"`
 }
 
 type Comment struct {
@@ -28,65 +32,157 @@ type Comment struct {
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	ArticleID uint
+	UserID    int
 	Username  string
 	Article   *Article
 	Author    *Author
+	DeletedAt time.Time
+}
+
+type TagCount struct {
+	Tag           string
+	ArticlesCount int
 }
 
 type ArticleRepo interface {
+	// slug 已被占用时返回 409
 	Create(ctx context.Context, ar *Article) (*Article, error)
-	List(ctx context.Context, opt ...ListOption) ([]*Article, error)
+	// 按 tag/author/favorited 过滤文章, 按发布时间倒序, 返回当页文章与总数
+	List(ctx context.Context, opt ...ListOption) ([]*Article, int64, error)
+	// 查询用户关注的作者发表的文章, 按发布时间倒序, 返回当页文章与总数
+	ListFeed(ctx context.Context, userId int, opt ...ListOption) ([]*Article, int64, error)
 	Get(ctx context.Context, articleId int) (*Article, error)
+	// 按 slug 查询文章, 包含标题修改前使用过的旧 slug
+	GetBySlug(ctx context.Context, slug string) (*Article, error)
+	// slug 是否已被其它文章使用(含旧 slug)
+	SlugExists(ctx context.Context, slug string, excludeArticleId int) (bool, error)
+	// 更新文章内容与 slug, slug 已被占用时返回 409; 点赞数由 FavoriteRepo 维护, 不随文章更新
 	Update(ctx context.Context, articleId int, ar *Article) (*Article, error)
+	// 软删除
 	Delete(ctx context.Context, articleId int) error
+	// 查询文章, 包含已删除的文章
+	GetUnscoped(ctx context.Context, articleId int) (*Article, error)
+	// 恢复已删除的文章
+	Restore(ctx context.Context, articleId int) error
+	// 彻底删除文章及其评论、点赞与旧 slug
+	Purge(ctx context.Context, articleId int) error
 }
 
 type CommentRepo interface {
 	Create(ctx context.Context, articleId int, c *Comment) (*Comment, error)
 	Get(ctx context.Context, commentId uint) (*Comment, error)
 	List(ctx context.Context, articleId int) ([]*Comment, error)
+	// 软删除
 	Delete(ctx context.Context, id uint) error
+	// 查询评论, 包含已删除的评论
+	GetUnscoped(ctx context.Context, commentId uint) (*Comment, error)
+	Restore(ctx context.Context, id uint) error
+	Purge(ctx context.Context, id uint) error
 }
 
 type TagRepo interface {
 	Create(ctx context.Context, ar *Article) (*Article, error)
 	Get(ctx context.Context, ar *Article, arId int) (*Article, error)
+	// 软删除文章的标签
 	Delete(ctx context.Context, arId int) error
+	Restore(ctx context.Context, arId int) error
+	Purge(ctx context.Context, arId int) error
+	// 按使用次数倒序列出标签, since 为零值时不限时间
+	ListPopular(ctx context.Context, since time.Time, limit int) ([]*TagCount, error)
+}
+
+type FavoriteRepo interface {
+	Favorite(ctx context.Context, userId, articleId int) (bool, error)
+	Unfavorite(ctx context.Context, userId, articleId int) (bool, error)
+	// 批量查询用户是否点赞了文章, 返回 articleId => favorited
+	GetFavorited(ctx context.Context, userId int, articleIds []int) (map[int]bool, error)
 }
 
 type SocialUsecase struct {
 	ar  ArticleRepo
 	cr  CommentRepo
 	tr  TagRepo
+	fr  FavoriteRepo
 	ur  UserRepo
+	pr  ProfileRepo
+	az  *Authorizer
+	tx  Transaction
 	log *log.Helper
+
+	translit Transliterator
+}
+
+func NewSocialUseCase(ar ArticleRepo, cr CommentRepo, tr TagRepo, fr FavoriteRepo, ur UserRepo, pr ProfileRepo, az *Authorizer, tx Transaction, logger log.Logger) *SocialUsecase {
+	return &SocialUsecase{ar: ar, cr: cr, tr: tr, fr: fr, ur: ur, pr: pr, az: az, tx: tx, log: log.NewHelper(logger), translit: PinyinTransliterator}
 }
 
-func NewSocialUseCase(ar ArticleRepo, cr CommentRepo, tr TagRepo, logger log.Logger) *SocialUsecase {
-	return &SocialUsecase{ar: ar, cr: cr, tr: tr, log: log.NewHelper(logger)}
+// 填充当前登录用户对文章的点赞状态
+func (s *SocialUsecase) fillFavorited(ctx context.Context, ars ...*Article) error {
+	loginUser, ok := auth.FromContext(ctx)
+	if !ok || len(ars) == 0 {
+		return nil
+	}
+	ids := make([]int, 0, len(ars))
+	for _, v := range ars {
+		ids = append(ids, v.ID)
+	}
+	favorited, err := s.fr.GetFavorited(ctx, loginUser.UserID, ids)
+	if err != nil {
+		return err
+	}
+	for _, v := range ars {
+		v.Favorited = favorited[v.ID]
+	}
+	return nil
 }
 
 func (s *SocialUsecase) CreateArticle(ctx context.Context, ar *Article) (*Article, error) {
-	// 创建文章
-	arr, err := s.ar.Create(ctx, ar)
-	// 创建tag
-	if len(arr.TagList) > 0 {
-		arr, err = s.tr.Create(ctx, arr)
+	loginUser, err := s.az.CurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ar.Username = loginUser.Username
+	ar.Author = Author{UserID: loginUser.UserID, Username: loginUser.Username}
+	// slug、文章与tag在同一事务中创建
+	var arr *Article
+	err = s.tx.InTx(ctx, func(ctx context.Context) error {
+		if ar.Slug, err = s.uniqueSlug(ctx, ar.Title, 0); err != nil {
+			return err
+		}
+		arr, err = s.ar.Create(ctx, ar)
 		if err != nil {
-			return arr, err
+			return err
+		}
+		if len(arr.TagList) > 0 {
+			arr, err = s.tr.Create(ctx, arr)
 		}
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
-	return arr, nil
+	return arr, s.fillArticles(ctx, arr)
 }
 
-func (s *SocialUsecase) ListArticles(ctx context.Context, opt ...ListOption) (rv []*Article, err error) {
-	rv, err = s.ar.List(ctx, opt...)
-	return rv, err
+func (s *SocialUsecase) ListArticles(ctx context.Context, opt ...ListOption) (rv []*Article, count int64, err error) {
+	rv, count, err = s.ar.List(ctx, opt...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return rv, count, s.fillArticles(ctx, rv...)
 }
 
-func (s *SocialUsecase) FeedArticles(ctx context.Context, opt ...ListOption) (rv []*Article, err error) {
-	rv, err = s.ar.List(ctx, opt...)
-	return rv, err
+// 关注的作者的文章
+func (s *SocialUsecase) FeedArticles(ctx context.Context, opt ...ListOption) (rv []*Article, count int64, err error) {
+	loginUser, err := s.az.CurrentUser(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	rv, count, err = s.ar.ListFeed(ctx, loginUser.UserID, opt...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return rv, count, s.fillArticles(ctx, rv...)
 }
 
 func (s *SocialUsecase) GetArticle(ctx context.Context, articleId int) (do *Article, err error) {
@@ -95,15 +191,38 @@ func (s *SocialUsecase) GetArticle(ctx context.Context, articleId int) (do *Arti
 		return nil, err
 	}
 	do, err = s.tr.Get(ctx, do, do.ID)
-	return
+	if err != nil {
+		return nil, err
+	}
+	return do, s.fillArticles(ctx, do)
 }
 
 func (s *SocialUsecase) UpdateArticle(ctx context.Context, articleId int, do *Article) (*Article, error) {
-	do, err := s.ar.Update(ctx, articleId, do)
+	old, err := s.ar.Get(ctx, articleId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.az.Authorize(ctx, ActionUpdate, Resource{Kind: "article", ID: old.ID, OwnerID: old.Author.UserID}); err != nil {
+		return nil, err
+	}
+	// 标题修改后重新生成 slug, 旧 slug 由 repo 保留用于跳转; slug 的生成与写入在同一事务中
+	err = s.tx.InTx(ctx, func(ctx context.Context) error {
+		if do.Title != "" && do.Title != old.Title {
+			if do.Slug, err = s.uniqueSlug(ctx, do.Title, articleId); err != nil {
+				return err
+			}
+		}
+		do, err = s.ar.Update(ctx, articleId, do)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
-	return s.tr.Get(ctx, do, do.ID)
+	do, err = s.tr.Get(ctx, do, do.ID)
+	if err != nil {
+		return nil, err
+	}
+	return do, s.fillArticles(ctx, do)
 }
 
 func (s *SocialUsecase) DeleteArticle(ctx context.Context, articleId int) (*Article, error) {
@@ -111,11 +230,303 @@ func (s *SocialUsecase) DeleteArticle(ctx context.Context, articleId int) (*Arti
 	if err != nil {
 		return nil, err
 	}
-	if err := s.ar.Delete(ctx, articleId); err != nil {
-		return ar, err
+	if _, err := s.az.Authorize(ctx, ActionDelete, Resource{Kind: "article", ID: ar.ID, OwnerID: ar.Author.UserID}); err != nil {
+		return nil, err
 	}
-	if err := s.tr.Delete(ctx, ar.ID); err != nil {
-		return ar, err
+	if err := s.fillArticles(ctx, ar); err != nil {
+		return nil, err
+	}
+	// 文章与tag在同一事务中删除
+	err = s.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := s.ar.Delete(ctx, articleId); err != nil {
+			return err
+		}
+		return s.tr.Delete(ctx, ar.ID)
+	})
+	if err != nil {
+		return nil, err
 	}
 	return ar, nil
 }
+
+// 恢复已删除的文章及其标签
+func (s *SocialUsecase) RestoreArticle(ctx context.Context, articleId int) (*Article, error) {
+	ar, err := s.ar.GetUnscoped(ctx, articleId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.az.Authorize(ctx, ActionRestore, Resource{Kind: "article", ID: ar.ID, OwnerID: ar.Author.UserID}); err != nil {
+		return nil, err
+	}
+	if ar.DeletedAt.IsZero() {
+		return s.GetArticle(ctx, articleId)
+	}
+	err = s.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := s.ar.Restore(ctx, articleId); err != nil {
+			return err
+		}
+		return s.tr.Restore(ctx, articleId)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetArticle(ctx, articleId)
+}
+
+// 彻底删除文章, 包括已删除的文章
+func (s *SocialUsecase) PurgeArticle(ctx context.Context, articleId int) (*Article, error) {
+	ar, err := s.ar.GetUnscoped(ctx, articleId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.az.Authorize(ctx, ActionPurge, Resource{Kind: "article", ID: ar.ID, OwnerID: ar.Author.UserID}); err != nil {
+		return nil, err
+	}
+	if ar, err = s.tr.Get(ctx, ar, ar.ID); err != nil {
+		return nil, err
+	}
+	if err := s.fillArticles(ctx, ar); err != nil {
+		return nil, err
+	}
+	err = s.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := s.ar.Purge(ctx, articleId); err != nil {
+			return err
+		}
+		return s.tr.Purge(ctx, articleId)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ar, nil
+}
+
+// 点赞文章
+func (s *SocialUsecase) FavoriteArticle(ctx context.Context, articleId int) (*Article, error) {
+	loginUser, err := s.az.CurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.ar.Get(ctx, articleId); err != nil {
+		return nil, err
+	}
+	if _, err := s.fr.Favorite(ctx, loginUser.UserID, articleId); err != nil {
+		return nil, err
+	}
+	return s.GetArticle(ctx, articleId)
+}
+
+// 取消点赞文章
+func (s *SocialUsecase) UnfavoriteArticle(ctx context.Context, articleId int) (*Article, error) {
+	loginUser, err := s.az.CurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.ar.Get(ctx, articleId); err != nil {
+		return nil, err
+	}
+	if _, err := s.fr.Unfavorite(ctx, loginUser.UserID, articleId); err != nil {
+		return nil, err
+	}
+	return s.GetArticle(ctx, articleId)
+}
+
+// 批量加载作者信息及当前登录用户的关注状态, 返回 userId => Author
+func (s *SocialUsecase) loadAuthors(ctx context.Context, userIds []int) (map[int]*Author, error) {
+	authors := make(map[int]*Author)
+	ids := []int{}
+	for _, id := range userIds {
+		if _, ok := authors[id]; ok {
+			continue
+		}
+		authors[id] = &Author{UserID: id}
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return authors, nil
+	}
+	us, err := s.ur.GetUsersByUserIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	found := make([]int, 0, len(us))
+	for _, u := range us {
+		authors[u.UserID] = &Author{
+			UserID:   u.UserID,
+			Username: u.Username,
+			Bio:      u.Bio,
+			Image:    u.Image,
+		}
+		found = append(found, u.UserID)
+	}
+	// 已删除的作者不显示关注状态
+	if loginUser, ok := auth.FromContext(ctx); ok && len(found) > 0 {
+		following, err := s.pr.GetFollowing(ctx, loginUser.UserID, found)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range found {
+			authors[id].Following = following[id]
+		}
+	}
+	return authors, nil
+}
+
+// 填充文章作者信息与点赞状态
+func (s *SocialUsecase) fillArticles(ctx context.Context, ars ...*Article) error {
+	ids := make([]int, 0, len(ars))
+	for _, v := range ars {
+		ids = append(ids, v.Author.UserID)
+	}
+	authors, err := s.loadAuthors(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, v := range ars {
+		v.Author = *authors[v.Author.UserID]
+		v.Username = v.Author.Username
+	}
+	return s.fillFavorited(ctx, ars...)
+}
+
+// 填充评论作者信息
+func (s *SocialUsecase) fillCommentAuthors(ctx context.Context, cs ...*Comment) error {
+	ids := make([]int, 0, len(cs))
+	for _, c := range cs {
+		ids = append(ids, c.UserID)
+	}
+	authors, err := s.loadAuthors(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, c := range cs {
+		a := *authors[c.UserID]
+		c.Author = &a
+		c.Username = a.Username
+	}
+	return nil
+}
+
+// 添加评论
+func (s *SocialUsecase) AddComment(ctx context.Context, articleId int, body string) (*Comment, error) {
+	loginUser, err := s.az.CurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if body == "" {
+		return nil, errors.ParamInvalid("body", "cannot empty")
+	}
+	if _, err := s.ar.Get(ctx, articleId); err != nil {
+		return nil, err
+	}
+	c, err := s.cr.Create(ctx, articleId, &Comment{
+		Body:      body,
+		ArticleID: uint(articleId),
+		UserID:    loginUser.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, s.fillCommentAuthors(ctx, c)
+}
+
+// 获取文章评论
+func (s *SocialUsecase) ListComments(ctx context.Context, articleId int) ([]*Comment, error) {
+	if _, err := s.ar.Get(ctx, articleId); err != nil {
+		return nil, err
+	}
+	cs, err := s.cr.List(ctx, articleId)
+	if err != nil {
+		return nil, err
+	}
+	return cs, s.fillCommentAuthors(ctx, cs...)
+}
+
+// 删除评论, 仅评论作者或文章作者可删除
+func (s *SocialUsecase) DeleteComment(ctx context.Context, articleId int, commentId uint) (*Comment, error) {
+	ar, err := s.ar.Get(ctx, articleId)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.cr.Get(ctx, commentId)
+	if err != nil {
+		return nil, err
+	}
+	if c.ArticleID != uint(articleId) {
+		return nil, errors.NotFound("comment", "not found by id")
+	}
+	res := Resource{Kind: "comment", ID: int(c.ID), OwnerID: c.UserID, ArticleOwnerID: ar.Author.UserID}
+	if _, err := s.az.Authorize(ctx, ActionDelete, res); err != nil {
+		return nil, err
+	}
+	if err := s.fillCommentAuthors(ctx, c); err != nil {
+		return nil, err
+	}
+	if err := s.cr.Delete(ctx, commentId); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// 查询文章下的评论及授权资源, 包含已删除的文章和评论
+func (s *SocialUsecase) getCommentResource(ctx context.Context, articleId int, commentId uint) (*Comment, Resource, error) {
+	ar, err := s.ar.GetUnscoped(ctx, articleId)
+	if err != nil {
+		return nil, Resource{}, err
+	}
+	c, err := s.cr.GetUnscoped(ctx, commentId)
+	if err != nil {
+		return nil, Resource{}, err
+	}
+	if c.ArticleID != uint(articleId) {
+		return nil, Resource{}, errors.NotFound("comment", "not found by id")
+	}
+	return c, Resource{Kind: "comment", ID: int(c.ID), OwnerID: c.UserID, ArticleOwnerID: ar.Author.UserID}, nil
+}
+
+// 恢复已删除的评论
+func (s *SocialUsecase) RestoreComment(ctx context.Context, articleId int, commentId uint) (*Comment, error) {
+	c, res, err := s.getCommentResource(ctx, articleId, commentId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.az.Authorize(ctx, ActionRestore, res); err != nil {
+		return nil, err
+	}
+	if !c.DeletedAt.IsZero() {
+		if err := s.cr.Restore(ctx, commentId); err != nil {
+			return nil, err
+		}
+		c.DeletedAt = time.Time{}
+	}
+	return c, s.fillCommentAuthors(ctx, c)
+}
+
+// 彻底删除评论, 包括已删除的评论
+func (s *SocialUsecase) PurgeComment(ctx context.Context, articleId int, commentId uint) (*Comment, error) {
+	c, res, err := s.getCommentResource(ctx, articleId, commentId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.az.Authorize(ctx, ActionPurge, res); err != nil {
+		return nil, err
+	}
+	if err := s.fillCommentAuthors(ctx, c); err != nil {
+		return nil, err
+	}
+	if err := s.cr.Purge(ctx, commentId); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// 热门标签, days 为统计最近天数, 0 表示不限
+func (s *SocialUsecase) ListTags(ctx context.Context, days, limit int) ([]*TagCount, error) {
+	if limit <= 0 || limit > 100 {
+		limit = DefaultListLimit
+	}
+	var since time.Time
+	if days > 0 {
+		since = time.Now().AddDate(0, 0, -days)
+	}
+	return s.tr.ListPopular(ctx, since, limit)
+}
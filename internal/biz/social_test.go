@@ -0,0 +1,422 @@
+package biz
+
+import (
+	"context"
+	"demo/internal/pkg/middleware/auth"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type txKey struct{}
+
+// 在 context 中标记事务的 Transaction, 不支持回滚
+type txStub struct{}
+
+func (txStub) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(context.WithValue(ctx, txKey{}, true))
+}
+
+// 以 map 保存文章的 ArticleRepo, 只实现用到的方法
+type articleStub struct {
+	ArticleRepo
+	ars map[int]*Article
+	// slug => articleId, 包含修改标题前的旧 slug
+	slugs map[string]int
+	// 在事务外读写 slug 的次数
+	outsideTx int
+	// 最近一次 ListFeed 查询的用户
+	feedUserId int
+}
+
+func (r *articleStub) checkTx(ctx context.Context) {
+	if ctx.Value(txKey{}) == nil {
+		r.outsideTx++
+	}
+}
+
+func (r *articleStub) Create(ctx context.Context, ar *Article) (*Article, error) {
+	r.checkTx(ctx)
+	ar.ID = len(r.ars) + 1
+	cp := *ar
+	r.ars[ar.ID] = &cp
+	r.slugs[ar.Slug] = ar.ID
+	return ar, nil
+}
+
+func (r *articleStub) Update(ctx context.Context, articleId int, do *Article) (*Article, error) {
+	r.checkTx(ctx)
+	ar := r.ars[articleId]
+	if do.Title != "" {
+		ar.Title = do.Title
+	}
+	if do.Slug != "" {
+		ar.Slug = do.Slug
+		r.slugs[do.Slug] = articleId
+	}
+	return r.Get(ctx, articleId)
+}
+
+func (r *articleStub) GetBySlug(ctx context.Context, slug string) (*Article, error) {
+	id, ok := r.slugs[slug]
+	if !ok {
+		return nil, errors.NotFound("article", "not found by slug")
+	}
+	return r.Get(ctx, id)
+}
+
+func (r *articleStub) SlugExists(ctx context.Context, slug string, excludeArticleId int) (bool, error) {
+	r.checkTx(ctx)
+	id, ok := r.slugs[slug]
+	return ok && id != excludeArticleId, nil
+}
+
+func (r *articleStub) List(ctx context.Context, opt ...ListOption) ([]*Article, int64, error) {
+	ars := []*Article{}
+	for id := 1; id <= len(r.ars); id++ {
+		if ar, ok := r.ars[id]; ok {
+			cp := *ar
+			ars = append(ars, &cp)
+		}
+	}
+	return ars, int64(len(ars)), nil
+}
+
+func (r *articleStub) ListFeed(ctx context.Context, userId int, opt ...ListOption) ([]*Article, int64, error) {
+	r.feedUserId = userId
+	return []*Article{}, 0, nil
+}
+
+func (r *articleStub) Get(ctx context.Context, articleId int) (*Article, error) {
+	ar, ok := r.ars[articleId]
+	if !ok {
+		return nil, errors.NotFound("article", "not found by id")
+	}
+	cp := *ar
+	return &cp, nil
+}
+
+// 不保存标签的 TagRepo
+type tagStub struct {
+	TagRepo
+}
+
+func (tagStub) Get(ctx context.Context, ar *Article, arId int) (*Article, error) {
+	return ar, nil
+}
+
+// 以 {userId, followId} 保存关注关系的 ProfileRepo
+type profileStub struct {
+	ProfileRepo
+	follows map[[2]int]bool
+}
+
+func (r *profileStub) GetFollowing(ctx context.Context, myUserId int, userIds []int) (map[int]bool, error) {
+	rv := map[int]bool{}
+	for _, id := range userIds {
+		rv[id] = r.follows[[2]int{myUserId, id}]
+	}
+	return rv, nil
+}
+
+// 以 {userId, articleId} 保存点赞关系的 FavoriteRepo
+type favoriteStub struct {
+	FavoriteRepo
+	favorites map[[2]int]bool
+}
+
+func (r *favoriteStub) Favorite(ctx context.Context, userId, articleId int) (bool, error) {
+	created := !r.favorites[[2]int{userId, articleId}]
+	r.favorites[[2]int{userId, articleId}] = true
+	return created, nil
+}
+
+func (r *favoriteStub) Unfavorite(ctx context.Context, userId, articleId int) (bool, error) {
+	deleted := r.favorites[[2]int{userId, articleId}]
+	delete(r.favorites, [2]int{userId, articleId})
+	return deleted, nil
+}
+
+func (r *favoriteStub) GetFavorited(ctx context.Context, userId int, articleIds []int) (map[int]bool, error) {
+	rv := map[int]bool{}
+	for _, id := range articleIds {
+		rv[id] = r.favorites[[2]int{userId, id}]
+	}
+	return rv, nil
+}
+
+func TestFavoriteArticle(t *testing.T) {
+	ar := &articleStub{ars: map[int]*Article{1: {ID: 1, Title: "title", Author: Author{UserID: 1}}}}
+	fr := &favoriteStub{favorites: map[[2]int]bool{}}
+	sc := NewSocialUseCase(ar, nil, tagStub{}, fr, &userStub{}, nil, NewAuthorizer(), nil, log.DefaultLogger)
+	fanCtx := auth.NewContext(context.Background(), auth.LoginUser{UserID: 2, Role: auth.RoleUser})
+	otherCtx := auth.NewContext(context.Background(), auth.LoginUser{UserID: 3, Role: auth.RoleUser})
+
+	if _, err := sc.FavoriteArticle(context.Background(), 1); errors.Code(err) != 401 {
+		t.Fatalf("expected anonymous favorite to be rejected, got %v", err)
+	}
+	if _, err := sc.FavoriteArticle(fanCtx, 1000); errors.Code(err) != 404 || len(fr.favorites) != 0 {
+		t.Fatalf("expected unknown article to be rejected, got %v", err)
+	}
+	got, err := sc.FavoriteArticle(fanCtx, 1)
+	if err != nil || !got.Favorited || !fr.favorites[[2]int{2, 1}] {
+		t.Fatalf("expected article to be favorited by the viewer, got %+v %v", got, err)
+	}
+	// 点赞状态按当前登录用户返回
+	if got, err := sc.GetArticle(otherCtx, 1); err != nil || got.Favorited {
+		t.Fatalf("expected other viewer not to have favorited, got %+v %v", got, err)
+	}
+	got, err = sc.UnfavoriteArticle(fanCtx, 1)
+	if err != nil || got.Favorited || len(fr.favorites) != 0 {
+		t.Fatalf("expected favorite to be removed, got %+v %v", got, err)
+	}
+}
+
+// 记录 ListPopular 参数的 TagRepo
+type popularTagStub struct {
+	TagRepo
+	since time.Time
+	limit int
+}
+
+func (r *popularTagStub) ListPopular(ctx context.Context, since time.Time, limit int) ([]*TagCount, error) {
+	r.since, r.limit = since, limit
+	return []*TagCount{}, nil
+}
+
+func TestListTags(t *testing.T) {
+	tr := &popularTagStub{}
+	sc := NewSocialUseCase(nil, nil, tr, nil, nil, nil, NewAuthorizer(), nil, log.DefaultLogger)
+	ctx := context.Background()
+	// limit 超出范围时使用默认分页大小
+	for limit, want := range map[int]int{0: DefaultListLimit, -1: DefaultListLimit, 1000: DefaultListLimit, 100: 100} {
+		if _, err := sc.ListTags(ctx, 0, limit); err != nil {
+			t.Fatal(err)
+		}
+		if tr.limit != want || !tr.since.IsZero() {
+			t.Fatalf("limit %d: got limit %d since %v, want %d", limit, tr.limit, tr.since, want)
+		}
+	}
+	// 按天数统计时从当前时间倒推
+	if _, err := sc.ListTags(ctx, 7, 10); err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Since(tr.since); d < 7*24*time.Hour-time.Minute || d > 7*24*time.Hour+time.Minute {
+		t.Fatalf("expected tags since 7 days ago, got %v", tr.since)
+	}
+}
+
+// 以 map 保存评论的 CommentRepo
+type commentStub struct {
+	CommentRepo
+	cs map[uint]*Comment
+}
+
+func (r *commentStub) Create(ctx context.Context, articleId int, c *Comment) (*Comment, error) {
+	c.ID = uint(len(r.cs) + 1)
+	r.cs[c.ID] = c
+	cp := *c
+	return &cp, nil
+}
+
+func (r *commentStub) Get(ctx context.Context, commentId uint) (*Comment, error) {
+	c, ok := r.cs[commentId]
+	if !ok {
+		return nil, errors.NotFound("comment", "not found by id")
+	}
+	cp := *c
+	return &cp, nil
+}
+
+func (r *commentStub) List(ctx context.Context, articleId int) ([]*Comment, error) {
+	cs := []*Comment{}
+	for id := uint(1); id <= uint(len(r.cs)); id++ {
+		if c, ok := r.cs[id]; ok && c.ArticleID == uint(articleId) {
+			cp := *c
+			cs = append(cs, &cp)
+		}
+	}
+	return cs, nil
+}
+
+func (r *commentStub) Delete(ctx context.Context, id uint) error {
+	delete(r.cs, id)
+	return nil
+}
+
+func TestComments(t *testing.T) {
+	ar := &articleStub{ars: map[int]*Article{
+		1: {ID: 1, Author: Author{UserID: 1}},
+		2: {ID: 2, Author: Author{UserID: 1}},
+	}}
+	cr := &commentStub{cs: map[uint]*Comment{}}
+	ur := &userStub{users: map[int]*User{2: {UserID: 2, Username: "viewer"}, 3: {UserID: 3, Username: "stranger"}}}
+	sc := NewSocialUseCase(ar, cr, tagStub{}, nil, ur, &profileStub{}, NewAuthorizer(), nil, log.DefaultLogger)
+	newCtx := func(userId int) context.Context {
+		return auth.NewContext(context.Background(), auth.LoginUser{UserID: userId, Role: auth.RoleUser})
+	}
+	authorCtx, viewerCtx, strangerCtx := newCtx(1), newCtx(2), newCtx(3)
+
+	if _, err := sc.AddComment(viewerCtx, 1, ""); errors.Code(err) != 422 {
+		t.Fatalf("expected empty comment to be rejected, got %v", err)
+	}
+	if _, err := sc.AddComment(context.Background(), 1, "anonymous"); errors.Code(err) != 401 {
+		t.Fatalf("expected anonymous comment to be rejected, got %v", err)
+	}
+	if _, err := sc.AddComment(viewerCtx, 1000, "missing"); errors.Code(err) != 404 {
+		t.Fatalf("expected comment on unknown article to be rejected, got %v", err)
+	}
+	add := func(ctx context.Context, articleId int, body string) *Comment {
+		c, err := sc.AddComment(ctx, articleId, body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	first := add(viewerCtx, 1, "first")
+	second := add(strangerCtx, 1, "second")
+	elsewhere := add(viewerCtx, 2, "elsewhere")
+
+	// 只列出该文章的评论, 并带上作者信息
+	cs, err := sc.ListComments(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cs) != 2 || cs[0].ID != first.ID || cs[1].ID != second.ID {
+		t.Fatalf("expected comments of the article only, got %+v", cs)
+	}
+	if cs[0].Author.Username != "viewer" || cs[0].Username != "viewer" || cs[1].Author.Username != "stranger" {
+		t.Fatalf("unexpected comment authors: %+v %+v", cs[0].Author, cs[1].Author)
+	}
+	if _, err := sc.ListComments(context.Background(), 1000); errors.Code(err) != 404 {
+		t.Fatalf("expected comments of unknown article to be rejected, got %v", err)
+	}
+
+	// 不能删除其他用户的评论, 也不能通过其他文章删除
+	if _, err := sc.DeleteComment(strangerCtx, 1, first.ID); errors.Code(err) != 403 {
+		t.Fatalf("expected deleting another user's comment to be forbidden, got %v", err)
+	}
+	if _, err := sc.DeleteComment(viewerCtx, 1, elsewhere.ID); errors.Code(err) != 404 {
+		t.Fatalf("expected comment of another article to be hidden, got %v", err)
+	}
+	if _, err := sc.DeleteComment(context.Background(), 1, first.ID); errors.Code(err) != 401 {
+		t.Fatalf("expected anonymous delete to be rejected, got %v", err)
+	}
+	if _, err := sc.DeleteComment(viewerCtx, 1, first.ID); err != nil {
+		t.Fatal(err)
+	}
+	// 文章作者可以删除文章下的任意评论
+	if c, err := sc.DeleteComment(authorCtx, 1, second.ID); err != nil || c.UserID != 3 {
+		t.Fatalf("expected article author to delete the comment, got %+v %v", c, err)
+	}
+	if cs, err := sc.ListComments(context.Background(), 1); err != nil || len(cs) != 0 {
+		t.Fatalf("expected deleted comments to be hidden, got %+v %v", cs, err)
+	}
+}
+
+func TestFeedArticles(t *testing.T) {
+	ar := &articleStub{}
+	sc := NewSocialUseCase(ar, nil, tagStub{}, nil, &userStub{}, nil, NewAuthorizer(), nil, log.DefaultLogger)
+	if _, _, err := sc.FeedArticles(context.Background()); errors.Code(err) != 401 {
+		t.Fatalf("expected anonymous feed to be rejected, got %v", err)
+	}
+	ctx := auth.NewContext(context.Background(), auth.LoginUser{UserID: 2, Role: auth.RoleUser})
+	if _, _, err := sc.FeedArticles(ctx); err != nil || ar.feedUserId != 2 {
+		t.Fatalf("expected feed of the login user, got user %d %v", ar.feedUserId, err)
+	}
+}
+
+func TestArticleSlug(t *testing.T) {
+	ar := &articleStub{ars: map[int]*Article{}, slugs: map[string]int{}}
+	sc := NewSocialUseCase(ar, nil, tagStub{}, &favoriteStub{}, &userStub{}, nil, NewAuthorizer(), txStub{}, log.DefaultLogger)
+	ctx := auth.NewContext(context.Background(), auth.LoginUser{UserID: 1, Role: auth.RoleUser})
+	create := func(title string) *Article {
+		ar, err := sc.CreateArticle(ctx, &Article{Title: title, Body: "body"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ar
+	}
+	first := create("Hello World")
+	for i, want := range []string{"hello-world-2", "hello-world-3"} {
+		if ar := create("Hello, world!"); ar.Slug != want {
+			t.Fatalf("collision %d: got slug %q, want %q", i, ar.Slug, want)
+		}
+	}
+	// 与文章路由冲突的 slug 追加后缀
+	for title, want := range map[string]string{"Feed": "feed-2", "ID": "id-2"} {
+		if ar := create(title); ar.Slug != want {
+			t.Fatalf("reserved %q: got slug %q, want %q", title, ar.Slug, want)
+		}
+	}
+
+	// 修改标题后旧 slug 仍指向原文章, 且不会分配给新文章
+	updated, err := sc.UpdateArticle(ctx, first.ID, &Article{Title: "Goodbye"})
+	if err != nil || updated.Slug != "goodbye" {
+		t.Fatalf("expected slug to follow title, got %+v %v", updated, err)
+	}
+	if id, err := sc.ResolveSlug(ctx, "hello-world"); err != nil || id != first.ID {
+		t.Fatalf("expected old slug to resolve, got %d %v", id, err)
+	}
+	if ar := create("Hello World"); ar.Slug != "hello-world-4" {
+		t.Fatalf("expected old slug to stay reserved, got %q", ar.Slug)
+	}
+	if id, err := sc.ResolveSlug(ctx, strconv.Itoa(first.ID)); err != nil || id != first.ID {
+		t.Fatalf("expected numeric slug to resolve as id, got %d %v", id, err)
+	}
+	if _, err := sc.ResolveSlug(ctx, "missing"); errors.Code(err) != 404 {
+		t.Fatalf("expected unknown slug to be rejected, got %v", err)
+	}
+	// slug 的检查与写入在同一事务中
+	if ar.outsideTx != 0 {
+		t.Fatalf("expected slugs to be generated inside the transaction, got %d calls outside", ar.outsideTx)
+	}
+}
+
+func TestArticleAuthors(t *testing.T) {
+	ar := &articleStub{ars: map[int]*Article{1: {ID: 1, Author: Author{UserID: 1}}}}
+	ur := &userStub{users: map[int]*User{1: {UserID: 1, Username: "a", Bio: "bio"}}}
+	// viewer 关注了作者
+	pr := &profileStub{follows: map[[2]int]bool{{2, 1}: true}}
+	sc := NewSocialUseCase(ar, nil, tagStub{}, &favoriteStub{}, ur, pr, NewAuthorizer(), nil, log.DefaultLogger)
+	viewerCtx := auth.NewContext(context.Background(), auth.LoginUser{UserID: 2, Role: auth.RoleUser})
+	strangerCtx := auth.NewContext(context.Background(), auth.LoginUser{UserID: 3, Role: auth.RoleUser})
+
+	for name, c := range map[string]struct {
+		ctx       context.Context
+		following bool
+	}{
+		"follower":  {viewerCtx, true},
+		"stranger":  {strangerCtx, false},
+		"anonymous": {context.Background(), false},
+	} {
+		want := Author{UserID: 1, Username: "a", Bio: "bio", Following: c.following}
+		got, err := sc.GetArticle(c.ctx, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Author != want || got.Username != "a" {
+			t.Fatalf("%s: got author %+v", name, got.Author)
+		}
+		ars, _, err := sc.ListArticles(c.ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ars) != 1 || ars[0].Author != want {
+			t.Fatalf("%s: expected list author to match, got %+v", name, ars)
+		}
+	}
+
+	// 作者被删除后只保留 id, 不显示关注状态
+	delete(ur.users, 1)
+	got, err := sc.GetArticle(viewerCtx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Author != (Author{UserID: 1}) {
+		t.Fatalf("expected bare author for deleted user, got %+v", got.Author)
+	}
+}
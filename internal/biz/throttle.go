@@ -0,0 +1,205 @@
+package biz
+
+import (
+	"context"
+	"demo/internal/conf"
+	"demo/internal/errors"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultMaxAccountFailures = 5
+	defaultMaxIPFailures      = 20
+	defaultBaseLockout        = time.Minute
+	defaultMaxLockout         = time.Hour
+	defaultFailureWindow      = 15 * time.Minute
+)
+
+// LoginAttempt 某个账号或 IP 的登录失败状态
+type LoginAttempt struct {
+	Failures int
+	// 累计锁定次数, 不随失败计数窗口重置, 决定下次锁定时长
+	Lockouts    int
+	LockedUntil time.Time
+	// 账号失败的来源 IP 及其最近失败时间, IP 的失败状态中为空
+	IPs map[string]time.Time
+}
+
+// LoginAttemptRepo 登录失败计数, data 层提供内存与数据库两种实现
+type LoginAttemptRepo interface {
+	// 返回 key 的失败状态, 不存在时返回零值
+	Get(ctx context.Context, key string) (*LoginAttempt, error)
+	// 失败次数加一并返回最新状态, 距上次失败超过 window 时重新计数, ip 非空时记为失败来源
+	Incr(ctx context.Context, key, ip string, window time.Duration) (*LoginAttempt, error)
+	// 锁定到 until, lockouts 为包含本次在内的累计锁定次数
+	Lock(ctx context.Context, key string, until time.Time, lockouts int) error
+	// 清除失败状态及记录的来源 IP
+	Reset(ctx context.Context, key string) error
+}
+
+// LoginGuard 按账号与 IP 限制登录失败次数, 超过上限后锁定, 锁定时长随锁定次数指数增长.
+// 失败都来自同一个 IP 时只锁定该 IP, 避免他人从单个 IP 故意输错密码锁住账号
+type LoginGuard struct {
+	repo LoginAttemptRepo
+	c    *conf.LoginThrottle
+	log  *log.Helper
+}
+
+func NewLoginGuard(repo LoginAttemptRepo, c *conf.LoginThrottle, logger log.Logger) *LoginGuard {
+	return &LoginGuard{repo: repo, c: c, log: log.NewHelper(logger)}
+}
+
+func accountKey(email string) string {
+	return "account:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+func ipKey(ip string) string {
+	return "ip:" + ip
+}
+
+func (g *LoginGuard) maxFailures(key string) int {
+	if strings.HasPrefix(key, "ip:") {
+		if n := g.c.GetMaxIpFailures(); n > 0 {
+			return int(n)
+		}
+		return defaultMaxIPFailures
+	}
+	if n := g.c.GetMaxAccountFailures(); n > 0 {
+		return int(n)
+	}
+	return defaultMaxAccountFailures
+}
+
+func (g *LoginGuard) window() time.Duration {
+	if d := g.c.GetWindow(); d != nil && d.AsDuration() > 0 {
+		return d.AsDuration()
+	}
+	return defaultFailureWindow
+}
+
+func (g *LoginGuard) maxLockout() time.Duration {
+	if d := g.c.GetMaxLockout(); d != nil && d.AsDuration() > 0 {
+		return d.AsDuration()
+	}
+	return defaultMaxLockout
+}
+
+// 第 n 次锁定的时长: base * 2^(n-1), 不超过 max
+func (g *LoginGuard) lockout(n int) time.Duration {
+	base, max := defaultBaseLockout, g.maxLockout()
+	if d := g.c.GetBaseLockout(); d != nil && d.AsDuration() > 0 {
+		base = d.AsDuration()
+	}
+	d := base
+	for i := 1; i < n && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+	return d
+}
+
+func (g *LoginGuard) keys(email, ip string) []string {
+	keys := []string{accountKey(email)}
+	if ip != "" {
+		keys = append(keys, ipKey(ip))
+	}
+	return keys
+}
+
+// Check 账号或 IP 被锁定时返回 429, 并带上剩余锁定时长
+func (g *LoginGuard) Check(ctx context.Context, email, ip string) error {
+	if g.c.GetDisabled() {
+		return nil
+	}
+	now := time.Now()
+	for _, key := range g.keys(email, ip) {
+		a, err := g.repo.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		if a.LockedUntil.After(now) {
+			return errors.TooManyRequests("login", "too many failed attempts, try again later", a.LockedUntil.Sub(now))
+		}
+	}
+	return nil
+}
+
+// Fail 记录一次登录失败, 超过上限时锁定
+func (g *LoginGuard) Fail(ctx context.Context, email, ip string) error {
+	if g.c.GetDisabled() {
+		return nil
+	}
+	now := time.Now()
+	key := accountKey(email)
+	a, err := g.repo.Incr(ctx, key, ip, g.window())
+	if err != nil {
+		return err
+	}
+	// 来源 IP 未知时无法区分, 仍按账号锁定
+	if ip == "" || g.sources(a, now) > 1 {
+		if err := g.lock(ctx, key, a, now); err != nil {
+			return err
+		}
+	}
+	if ip == "" {
+		return nil
+	}
+	key = ipKey(ip)
+	if a, err = g.repo.Incr(ctx, key, "", g.window()); err != nil {
+		return err
+	}
+	return g.lock(ctx, key, a, now)
+}
+
+// 失败计数窗口内的不同来源 IP 数
+func (g *LoginGuard) sources(a *LoginAttempt, now time.Time) int {
+	n := 0
+	for _, t := range a.IPs {
+		if now.Sub(t) <= g.window() {
+			n++
+		}
+	}
+	return n
+}
+
+// 失败次数达到上限时锁定, 上次锁定结束超过 max_lockout 后锁定时长重新从 base_lockout 开始
+func (g *LoginGuard) lock(ctx context.Context, key string, a *LoginAttempt, now time.Time) error {
+	if a.Failures < g.maxFailures(key) {
+		return nil
+	}
+	n := a.Lockouts + 1
+	if !a.LockedUntil.IsZero() && now.Sub(a.LockedUntil) > g.maxLockout() {
+		n = 1
+	}
+	d := g.lockout(n)
+	g.log.Warnf("login locked: key=%s failures=%d lockouts=%d duration=%s", key, a.Failures, n, d)
+	return g.repo.Lock(ctx, key, now.Add(d), n)
+}
+
+// Succeed 登录成功后清除账号的失败计数, IP 计数保留到窗口过期
+func (g *LoginGuard) Succeed(ctx context.Context, email string) error {
+	if g.c.GetDisabled() {
+		return nil
+	}
+	return g.repo.Reset(ctx, accountKey(email))
+}
+
+// Unlock 解除账号锁定, 并清除该账号失败来源 IP 的计数与锁定, 锁定等级一并重置
+func (g *LoginGuard) Unlock(ctx context.Context, email string) error {
+	key := accountKey(email)
+	a, err := g.repo.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	for ip := range a.IPs {
+		if err := g.repo.Reset(ctx, ipKey(ip)); err != nil {
+			return err
+		}
+	}
+	return g.repo.Reset(ctx, key)
+}
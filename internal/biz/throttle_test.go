@@ -0,0 +1,202 @@
+package biz
+
+import (
+	"context"
+	"demo/internal/conf"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// 以 map 保存失败状态的 LoginAttemptRepo
+type attemptStub struct {
+	attempts   map[string]*LoginAttempt
+	lastFailed map[string]time.Time
+}
+
+func newAttemptStub() *attemptStub {
+	return &attemptStub{attempts: map[string]*LoginAttempt{}, lastFailed: map[string]time.Time{}}
+}
+
+func (r *attemptStub) Get(ctx context.Context, key string) (*LoginAttempt, error) {
+	if a, ok := r.attempts[key]; ok {
+		cp := *a
+		return &cp, nil
+	}
+	return &LoginAttempt{}, nil
+}
+
+func (r *attemptStub) Incr(ctx context.Context, key, ip string, window time.Duration) (*LoginAttempt, error) {
+	a, ok := r.attempts[key]
+	if !ok {
+		a = &LoginAttempt{IPs: map[string]time.Time{}}
+		r.attempts[key] = a
+	}
+	now := time.Now()
+	if now.Sub(r.lastFailed[key]) > window {
+		a.Failures = 0
+	}
+	a.Failures++
+	r.lastFailed[key] = now
+	if ip != "" {
+		a.IPs[ip] = now
+	}
+	return r.Get(ctx, key)
+}
+
+func (r *attemptStub) Lock(ctx context.Context, key string, until time.Time, lockouts int) error {
+	if a, ok := r.attempts[key]; ok {
+		a.LockedUntil, a.Lockouts = until, lockouts
+	}
+	return nil
+}
+
+func (r *attemptStub) Reset(ctx context.Context, key string) error {
+	delete(r.attempts, key)
+	delete(r.lastFailed, key)
+	return nil
+}
+
+// 所有时间前移 d, 模拟时间流逝
+func (r *attemptStub) elapse(d time.Duration) {
+	for key, a := range r.attempts {
+		a.LockedUntil = a.LockedUntil.Add(-d)
+		for ip, t := range a.IPs {
+			a.IPs[ip] = t.Add(-d)
+		}
+		r.lastFailed[key] = r.lastFailed[key].Add(-d)
+	}
+}
+
+func TestLoginGuard(t *testing.T) {
+	g := NewLoginGuard(newAttemptStub(), &conf.LoginThrottle{MaxAccountFailures: 2, MaxIpFailures: 3}, log.DefaultLogger)
+	ctx := context.Background()
+	if err := g.Fail(ctx, "a@b.c", "1.1.1.1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Check(ctx, "A@b.c", "1.1.1.1"); err != nil {
+		t.Fatalf("expected no lock after one failure, got %v", err)
+	}
+	if err := g.Fail(ctx, "a@b.c", "2.2.2.2"); err != nil {
+		t.Fatal(err)
+	}
+	err := g.Check(ctx, "a@b.c", "3.3.3.3")
+	if se := errors.FromError(err); se.Code != 429 || se.Metadata["retry_after"] == "" {
+		t.Fatalf("expected 429 with retry_after, got %v", err)
+	}
+	// IP 计数独立于账号
+	for _, email := range []string{"b@b.c", "c@b.c"} {
+		if err := g.Fail(ctx, email, "1.1.1.1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := g.Check(ctx, "d@b.c", "1.1.1.1"); errors.Code(err) != 429 {
+		t.Fatalf("expected ip lock, got %v", err)
+	}
+}
+
+// 失败都来自同一个 IP 时只锁定 IP, 账号从其他 IP 仍可登录
+func TestLoginGuardSingleIP(t *testing.T) {
+	g := NewLoginGuard(newAttemptStub(), &conf.LoginThrottle{MaxAccountFailures: 2, MaxIpFailures: 3}, log.DefaultLogger)
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		if err := g.Fail(ctx, "a@b.c", "1.1.1.1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := g.Check(ctx, "a@b.c", "1.1.1.1"); errors.Code(err) != 429 {
+		t.Fatalf("expected ip lock, got %v", err)
+	}
+	if err := g.Check(ctx, "a@b.c", "2.2.2.2"); err != nil {
+		t.Fatalf("expected account to stay unlocked, got %v", err)
+	}
+	// 来源 IP 未知时仍按账号锁定
+	for i := 0; i < 2; i++ {
+		if err := g.Fail(ctx, "b@b.c", ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := g.Check(ctx, "b@b.c", "2.2.2.2"); errors.Code(err) != 429 {
+		t.Fatalf("expected account lock for unknown ip, got %v", err)
+	}
+}
+
+// 锁定时长随锁定次数增长, 不因失败计数窗口过期而重置
+func TestLoginGuardEscalation(t *testing.T) {
+	repo := newAttemptStub()
+	g := NewLoginGuard(repo, &conf.LoginThrottle{
+		MaxAccountFailures: 2,
+		MaxIpFailures:      100,
+		BaseLockout:        durationpb.New(time.Minute),
+		MaxLockout:         durationpb.New(time.Hour),
+		Window:             durationpb.New(15 * time.Minute),
+	}, log.DefaultLogger)
+	ctx := context.Background()
+	fail := func(ips ...string) {
+		for _, ip := range ips {
+			if err := g.Fail(ctx, "a@b.c", ip); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	locked := func() time.Duration {
+		return time.Until(repo.attempts[accountKey("a@b.c")].LockedUntil)
+	}
+
+	fail("1.1.1.1", "2.2.2.2")
+	if d := locked(); d <= 0 || d > time.Minute {
+		t.Fatalf("expected first lockout of base duration, got %s", d)
+	}
+	// 锁定与失败计数窗口都已过期, 之前的来源 IP 也不再计入
+	repo.elapse(20 * time.Minute)
+	fail("3.3.3.3")
+	if d := locked(); d > 0 {
+		t.Fatalf("expected no lock after one failure, got %s", d)
+	}
+	fail("4.4.4.4")
+	if d := locked(); d <= time.Minute || d > 2*time.Minute {
+		t.Fatalf("expected second lockout to double, got %s", d)
+	}
+	repo.elapse(20 * time.Minute)
+	fail("5.5.5.5", "6.6.6.6")
+	if d := locked(); d <= 2*time.Minute || d > 4*time.Minute {
+		t.Fatalf("expected third lockout to double again, got %s", d)
+	}
+	// 上次锁定结束超过 max_lockout 后重新从 base_lockout 开始
+	repo.elapse(2 * time.Hour)
+	fail("7.7.7.7", "8.8.8.8")
+	if d := locked(); d <= 0 || d > time.Minute {
+		t.Fatalf("expected lockout to reset to base duration, got %s", d)
+	}
+}
+
+// 管理员解锁账号时一并解除其失败来源 IP 的锁定
+func TestLoginGuardUnlock(t *testing.T) {
+	repo := newAttemptStub()
+	g := NewLoginGuard(repo, &conf.LoginThrottle{MaxAccountFailures: 2, MaxIpFailures: 2}, log.DefaultLogger)
+	ctx := context.Background()
+	for _, ip := range []string{"1.1.1.1", "2.2.2.2", "1.1.1.1", "2.2.2.2"} {
+		if err := g.Fail(ctx, "a@b.c", ip); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, ip := range []string{"1.1.1.1", "2.2.2.2", "3.3.3.3"} {
+		if err := g.Check(ctx, "a@b.c", ip); errors.Code(err) != 429 {
+			t.Fatalf("%s: expected lock, got %v", ip, err)
+		}
+	}
+	if err := g.Unlock(ctx, "A@b.c"); err != nil {
+		t.Fatal(err)
+	}
+	for _, ip := range []string{"1.1.1.1", "2.2.2.2"} {
+		if err := g.Check(ctx, "a@b.c", ip); err != nil {
+			t.Fatalf("%s: expected unlocked, got %v", ip, err)
+		}
+	}
+	if len(repo.attempts) != 0 {
+		t.Fatalf("expected all attempts to be reset, got %v", repo.attempts)
+	}
+}
@@ -0,0 +1,278 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"demo/internal/conf"
+	"demo/internal/errors"
+	"demo/internal/pkg/middleware/auth"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultAccessTTL  = 15 * time.Minute
+	defaultRefreshTTL = 30 * 24 * time.Hour
+)
+
+// RefreshToken 持久化的 refresh token, 只保存 token 的哈希
+type RefreshToken struct {
+	ID        int
+	UserID    int
+	SessionID int
+	TokenHash string
+	ExpiresAt time.Time
+	RevokedAt time.Time
+}
+
+// TokenState 校验 access token 所需的状态
+type TokenState struct {
+	// 用户当前的 token 版本
+	Version int
+	// jti 是否在黑名单中
+	Revoked bool
+	// token 所属会话, 会话不存在或不属于该用户时为 nil
+	Session *Session
+}
+
+// TokenPair 登录后返回的 access token 与 refresh token
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+type TokenRepo interface {
+	CreateRefreshToken(ctx context.Context, t *RefreshToken) error
+	GetRefreshToken(ctx context.Context, tokenHash string) (*RefreshToken, error)
+	// 吊销 refresh token, 返回是否由本次调用吊销(已吊销时返回 false)
+	RevokeRefreshToken(ctx context.Context, id int) (bool, error)
+	RevokeUserRefreshTokens(ctx context.Context, userId int) error
+	// 将 access token ID 加入黑名单直到其过期
+	RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error
+	// 以一次查询取出校验 access token 所需的黑名单、会话和 token 版本, 用户不存在时返回 404
+	GetTokenState(ctx context.Context, userId int, jti string, sessionId int) (*TokenState, error)
+	GetTokenVersion(ctx context.Context, userId int) (int, error)
+	// token 版本加一, 使该用户已签发的 access token 全部失效
+	IncrTokenVersion(ctx context.Context, userId int) error
+}
+
+type TokenUsecase struct {
+	tr   TokenRepo
+	sr   SessionRepo
+	ur   UserRepo
+	tx   Transaction
+	ks   *auth.KeySet
+	jwtc *conf.JWT
+	mc   *conf.Mail
+	log  *log.Helper
+}
+
+func NewTokenUsecase(tr TokenRepo, sr SessionRepo, ur UserRepo, tx Transaction, ks *auth.KeySet, jwtc *conf.JWT, mc *conf.Mail, logger log.Logger) *TokenUsecase {
+	return &TokenUsecase{tr: tr, sr: sr, ur: ur, tx: tx, ks: ks, jwtc: jwtc, mc: mc, log: log.NewHelper(logger)}
+}
+
+func (tu *TokenUsecase) accessTTL() time.Duration {
+	if d := tu.jwtc.AccessTtl; d != nil && d.AsDuration() > 0 {
+		return d.AsDuration()
+	}
+	return defaultAccessTTL
+}
+
+func (tu *TokenUsecase) refreshTTL() time.Duration {
+	if d := tu.jwtc.RefreshTtl; d != nil && d.AsDuration() > 0 {
+		return d.AsDuration()
+	}
+	return defaultRefreshTTL
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// 生成随机的 refresh token
+func newRefreshToken() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// Issue 创建新的会话, 为用户签发 access token 与 refresh token
+func (tu *TokenUsecase) Issue(ctx context.Context, u *User) (*TokenPair, error) {
+	s, err := tu.newSession(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	return tu.issue(ctx, u, s.ID)
+}
+
+// 在已有会话中签发 token
+func (tu *TokenUsecase) issue(ctx context.Context, u *User, sessionId int) (*TokenPair, error) {
+	version, err := tu.tr.GetTokenVersion(ctx, u.UserID)
+	if err != nil {
+		return nil, err
+	}
+	lu := auth.LoginUser{Email: u.Email, Username: u.Username, UserID: u.UserID, Role: u.Role, SessionID: sessionId}
+	at, err := auth.GenerateToken(tu.ks, lu, version, tu.accessTTL())
+	if err != nil {
+		return nil, err
+	}
+	rt := newRefreshToken()
+	err = tu.tr.CreateRefreshToken(ctx, &RefreshToken{
+		UserID:    u.UserID,
+		SessionID: sessionId,
+		TokenHash: hashToken(rt),
+		ExpiresAt: time.Now().Add(tu.refreshTTL()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &TokenPair{AccessToken: at.Token, RefreshToken: rt}, nil
+}
+
+// Refresh 使用 refresh token 换取新的 token, 旧 refresh token 随即失效;
+// 已失效的 refresh token 被再次使用视为泄露, 吊销该用户全部 token
+func (tu *TokenUsecase) Refresh(ctx context.Context, refreshToken string) (*UserLogin, *TokenPair, error) {
+	if refreshToken == "" {
+		return nil, nil, errors.Unauthorized("refresh_token", "cannot empty")
+	}
+	rt, err := tu.tr.GetRefreshToken(ctx, hashToken(refreshToken))
+	if err != nil {
+		return nil, nil, errors.Unauthorized("refresh_token", "invalid")
+	}
+	if !rt.RevokedAt.IsZero() {
+		tu.log.Warnf("revoked refresh token reused, user_id=%d", rt.UserID)
+		if err := tu.revokeAll(ctx, rt.UserID); err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, errors.Unauthorized("refresh_token", "invalid")
+	}
+	if time.Now().After(rt.ExpiresAt) {
+		return nil, nil, errors.Unauthorized("refresh_token", "expired")
+	}
+	u, err := tu.ur.GetUserByUserID(ctx, rt.UserID)
+	if err != nil {
+		return nil, nil, errors.Unauthorized("refresh_token", "invalid")
+	}
+	// 与登录一致, 要求验证邮箱时未验证的用户不能续期
+	if tu.mc.GetRequireVerification() && !u.EmailVerified {
+		return nil, nil, errors.Forbidden("email", "not verified")
+	}
+	// 会话功能上线前签发的 refresh token 不属于任何会话, 刷新时创建新会话
+	if rt.SessionID != 0 {
+		s, err := tu.sr.GetSession(ctx, rt.SessionID)
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, nil, err
+		}
+		if err != nil || !s.Active(time.Now()) {
+			return nil, nil, errors.Unauthorized("refresh_token", "session has been revoked")
+		}
+	}
+	var pair *TokenPair
+	err = tu.tx.InTx(ctx, func(ctx context.Context) error {
+		ok, err := tu.tr.RevokeRefreshToken(ctx, rt.ID)
+		if err != nil {
+			return err
+		}
+		// 并发刷新时只有一个请求能成功
+		if !ok {
+			return errors.Unauthorized("refresh_token", "invalid")
+		}
+		if rt.SessionID == 0 {
+			pair, err = tu.Issue(ctx, u)
+			return err
+		}
+		now := time.Now()
+		if err := tu.sr.RefreshSession(ctx, rt.SessionID, clientFromContext(ctx), now, now.Add(tu.refreshTTL())); err != nil {
+			return err
+		}
+		pair, err = tu.issue(ctx, u, rt.SessionID)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return &UserLogin{
+		UserID:   u.UserID,
+		Email:    u.Email,
+		Username: u.Username,
+		Bio:      u.Bio,
+		Image:    u.Image,
+		Token:    pair.AccessToken,
+		Role:     u.Role,
+	}, pair, nil
+}
+
+// Logout 吊销当前 access token, refreshToken 不为空时一并吊销
+func (tu *TokenUsecase) Logout(ctx context.Context, refreshToken string) error {
+	loginUser, ok := auth.FromContext(ctx)
+	if !ok {
+		return errors.Unauthorized("user", "not login")
+	}
+	return tu.tx.InTx(ctx, func(ctx context.Context) error {
+		if loginUser.SessionID != 0 {
+			if _, err := tu.sr.RevokeSession(ctx, loginUser.UserID, loginUser.SessionID); err != nil {
+				return err
+			}
+		}
+		if refreshToken != "" {
+			rt, err := tu.tr.GetRefreshToken(ctx, hashToken(refreshToken))
+			if err == nil && rt.UserID == loginUser.UserID {
+				if _, err := tu.tr.RevokeRefreshToken(ctx, rt.ID); err != nil {
+					return err
+				}
+			}
+		}
+		if loginUser.TokenID == "" {
+			return nil
+		}
+		return tu.tr.RevokeAccessToken(ctx, loginUser.TokenID, loginUser.TokenExpiresAt)
+	})
+}
+
+// LogoutAll 退出所有设备, 吊销当前用户全部 token; 个人访问令牌不受影响, 需单独吊销
+func (tu *TokenUsecase) LogoutAll(ctx context.Context) error {
+	loginUser, ok := auth.FromContext(ctx)
+	if !ok {
+		return errors.Unauthorized("user", "not login")
+	}
+	return tu.revokeAll(ctx, loginUser.UserID)
+}
+
+func (tu *TokenUsecase) revokeAll(ctx context.Context, userId int) error {
+	return tu.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := tu.tr.IncrTokenVersion(ctx, userId); err != nil {
+			return err
+		}
+		if err := tu.sr.RevokeUserSessions(ctx, userId, 0); err != nil {
+			return err
+		}
+		return tu.tr.RevokeUserRefreshTokens(ctx, userId)
+	})
+}
+
+// IsRevoked 实现 auth.RevocationChecker, 校验 token 是否在黑名单中、所属会话是否已退出或版本已过期;
+// 每个请求一次查询, 会话活跃时间每 sessionTouchPeriod 最多额外写入一次
+func (tu *TokenUsecase) IsRevoked(ctx context.Context, claims *auth.CustomClaims) (bool, error) {
+	st, err := tu.tr.GetTokenState(ctx, claims.UserID, claims.Id, claims.SessionID)
+	// 用户已删除
+	if errors.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if st.Revoked {
+		return true, nil
+	}
+	if claims.SessionID != 0 && !tu.checkSession(ctx, st.Session) {
+		return true, nil
+	}
+	return claims.TokenVersion < st.Version, nil
+}
@@ -0,0 +1,106 @@
+package biz
+
+import (
+	"context"
+	"demo/internal/pkg/middleware/auth"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 以 map 保存 refresh token 与 token 版本的 TokenRepo
+type tokenStub struct {
+	TokenRepo
+	refresh  map[int]*RefreshToken
+	versions map[int]int
+}
+
+func (r *tokenStub) CreateRefreshToken(ctx context.Context, t *RefreshToken) error {
+	t.ID = len(r.refresh) + 1
+	cp := *t
+	r.refresh[t.ID] = &cp
+	return nil
+}
+
+func (r *tokenStub) GetRefreshToken(ctx context.Context, tokenHash string) (*RefreshToken, error) {
+	for _, t := range r.refresh {
+		if t.TokenHash == tokenHash {
+			cp := *t
+			return &cp, nil
+		}
+	}
+	return nil, errors.NotFound("refresh_token", "not found")
+}
+
+func (r *tokenStub) RevokeRefreshToken(ctx context.Context, id int) (bool, error) {
+	t, ok := r.refresh[id]
+	if !ok || !t.RevokedAt.IsZero() {
+		return false, nil
+	}
+	t.RevokedAt = time.Now()
+	return true, nil
+}
+
+func (r *tokenStub) RevokeUserRefreshTokens(ctx context.Context, userId int) error {
+	for _, t := range r.refresh {
+		if t.UserID == userId && t.RevokedAt.IsZero() {
+			t.RevokedAt = time.Now()
+		}
+	}
+	return nil
+}
+
+func (r *tokenStub) GetTokenVersion(ctx context.Context, userId int) (int, error) {
+	return r.versions[userId], nil
+}
+
+func (r *tokenStub) IncrTokenVersion(ctx context.Context, userId int) error {
+	r.versions[userId]++
+	return nil
+}
+
+// 返回固定状态的 TokenRepo, 记录查询次数
+type tokenStateStub struct {
+	TokenRepo
+	st    *TokenState
+	calls int
+}
+
+func (r *tokenStateStub) GetTokenState(ctx context.Context, userId int, jti string, sessionId int) (*TokenState, error) {
+	r.calls++
+	if r.st == nil {
+		return nil, errors.NotFound("user", "not found")
+	}
+	return r.st, nil
+}
+
+func TestIsRevoked(t *testing.T) {
+	now := time.Now()
+	active := &Session{ID: 1, UserID: 1, LastSeenAt: now, ExpiresAt: now.Add(time.Hour)}
+	revoked := &Session{ID: 1, UserID: 1, LastSeenAt: now, ExpiresAt: now.Add(time.Hour), RevokedAt: now}
+	claims := &auth.CustomClaims{LoginUser: auth.LoginUser{UserID: 1, SessionID: 1}, TokenVersion: 1}
+	claims.Id = "jti"
+	for _, c := range []struct {
+		name string
+		st   *TokenState
+		want bool
+	}{
+		{"valid", &TokenState{Version: 1, Session: active}, false},
+		{"deleted user", nil, true},
+		{"revoked jti", &TokenState{Version: 1, Revoked: true, Session: active}, true},
+		{"unknown session", &TokenState{Version: 1}, true},
+		{"revoked session", &TokenState{Version: 1, Session: revoked}, true},
+		{"old version", &TokenState{Version: 2, Session: active}, true},
+	} {
+		tr := &tokenStateStub{st: c.st}
+		tu := NewTokenUsecase(tr, nil, nil, nil, nil, nil, nil, log.DefaultLogger)
+		if got, err := tu.IsRevoked(context.Background(), claims); err != nil || got != c.want {
+			t.Fatalf("%s: got %v %v, want %v", c.name, got, err, c.want)
+		}
+		if tr.calls != 1 {
+			t.Fatalf("%s: expected 1 lookup, got %d", c.name, tr.calls)
+		}
+	}
+}
@@ -0,0 +1,9 @@
+package biz
+
+import "context"
+
+// Transaction 事务, fn 内使用传入的 ctx 调用 repo 即在同一事务中执行
+// fn 返回错误时回滚, 否则提交; 嵌套调用时复用外层事务
+type Transaction interface {
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -3,12 +3,10 @@ package biz
 import (
 	"context"
 	v1 "demo/api/realworld/v1"
-	"demo/internal/conf"
+	"demo/internal/errors"
 	"demo/internal/pkg/middleware/auth"
 
-	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
-	"golang.org/x/crypto/bcrypt"
 )
 
 // DO
@@ -20,6 +18,11 @@ type User struct {
 	Bio        string
 	Image      string
 	PasswdHash string
+	Role       string
+	// 邮箱是否已验证
+	EmailVerified bool
+	// 是否已启用 TOTP 两步验证
+	TOTPEnabled bool
 }
 type Follow struct {
 	Following string
@@ -33,12 +36,17 @@ type Author struct {
 	Following bool   `json:"following"`
 }
 type UserLogin struct {
-	UserID   int
-	Email    string
-	Username string
-	Token    string
-	Bio      string
-	Image    string
+	UserID        int
+	Email         string
+	Username      string
+	Token         string
+	RefreshToken  string
+	Bio           string
+	Image         string
+	Role          string
+	EmailVerified bool
+	// 已启用两步验证时只返回 challenge token, 需调用 LoginMFA 换取 token
+	MFAToken string
 }
 
 type UserRepo interface {
@@ -47,121 +55,212 @@ type UserRepo interface {
 	VerifyUserExistByEmail(ctx context.Context, email string) bool
 	GetUserByUserID(ctx context.Context, id int) (*User, error)
 	GetUserByUserName(ctx context.Context, name string) (*User, error)
+	// 批量查询用户, 不存在的用户不返回
+	GetUsersByUserIDs(ctx context.Context, ids []int) ([]*User, error)
 	UpdateUser(ctx context.Context, user_id int, user *User) (*User, error)
+	// 修改角色, 同时使该用户已签发的 token 失效
+	SetUserRole(ctx context.Context, userId int, role string) error
+	MarkEmailVerified(ctx context.Context, userId int) error
 }
 
 type ProfileRepo interface {
 	GetFollowByUserID(ctx context.Context, userId int) (*Follow, error)
 	FollowUser(ctx context.Context, myUserId, userId int) (bool, error)
 	UnfollowUser(ctx context.Context, myUserId, userId int) (bool, error)
+	// 批量查询是否关注了用户, 返回 userId => following
+	GetFollowing(ctx context.Context, myUserId int, userIds []int) (map[int]bool, error)
 }
 
 type UserUsecase struct {
-	ur   UserRepo
-	pr   ProfileRepo
-	jwtc *conf.JWT
-	log  *log.Helper
+	ur    UserRepo
+	pr    ProfileRepo
+	tu    *TokenUsecase
+	au    *AccountUsecase
+	guard *LoginGuard
+	pm    *PasswordManager
+	log   *log.Helper
 }
 
-func NewUserUseCase(ur UserRepo, pr ProfileRepo, jwtc *conf.JWT, logger log.Logger) *UserUsecase {
-	return &UserUsecase{ur: ur, pr: pr, jwtc: jwtc, log: log.NewHelper(logger)}
+func NewUserUseCase(ur UserRepo, pr ProfileRepo, tu *TokenUsecase, au *AccountUsecase, guard *LoginGuard, pm *PasswordManager, logger log.Logger) *UserUsecase {
+	return &UserUsecase{ur: ur, pr: pr, tu: tu, au: au, guard: guard, pm: pm, log: log.NewHelper(logger)}
 }
 
-func hashpassword(pwd string) string {
-	hash, _ := bcrypt.GenerateFromPassword([]byte(pwd), bcrypt.DefaultCost)
-	return string(hash)
-}
-
-func verifyPassword(hashpwd, pwd string) bool {
-	if err := bcrypt.CompareHashAndPassword([]byte(hashpwd), []byte(pwd)); err != nil {
-		return false
-	}
-	return true
-}
-
-// 生成token
-func (uc *UserUsecase) generateToken(u *User) string {
-	token, err := auth.GenerateToken([]byte(uc.jwtc.Secret), u.Email, u.Username, u.UserID)
-	if err != nil {
-		panic("create token error")
+// 解析登录信息, 由认证中间件写入 context, 未登录返回 401
+func (uc *UserUsecase) ParseLoginInfo(ctx context.Context) (auth.LoginUser, error) {
+	u, ok := auth.FromContext(ctx)
+	if !ok {
+		return u, errors.Unauthorized("user", "not login")
 	}
-	return token
-}
-
-// 解析登录信息
-func (uc *UserUsecase) ParseLoginInfo(ctx context.Context) auth.LoginUser {
-	ctx, err := auth.ParseTokenByCtx(ctx, []byte(uc.jwtc.Secret))
-	if err != nil {
-		panic("parse token error")
-	}
-	return ctx.Value("loginUser").(auth.LoginUser)
+	return u, nil
 }
 
 // 注册
 func (uc *UserUsecase) Register(ctx context.Context, username, email, password string) (*UserLogin, error) {
 	// 注册前判断用户email是否存在
 	if uc.ur.VerifyUserExistByEmail(ctx, email) {
-		return nil, errors.New(422, "email", "has exist")
+		return nil, errors.ParamInvalid("email", "has exist")
+	}
+	if err := uc.pm.Validate(password); err != nil {
+		return nil, err
+	}
+	hash, err := uc.pm.Hash(password)
+	if err != nil {
+		return nil, err
 	}
 	// 注册
 	u := &User{
 		Email:      email,
 		Username:   username,
-		PasswdHash: hashpassword(password),
+		PasswdHash: hash,
+		Role:       auth.RoleUser,
 	}
 	if err := uc.ur.CreateUser(ctx, u); err != nil {
 		return nil, err
 	}
+	// 验证邮件发送失败不影响注册, 用户可按邮箱重新请求发送
+	if err := uc.au.SendVerificationEmail(ctx, u); err != nil {
+		uc.log.Errorf("send verification email to %s: %v", u.Email, err)
+	}
+	// 要求验证邮箱时不签发 token, 验证后再登录
+	if uc.au.RequireVerification() {
+		return &UserLogin{UserID: u.UserID, Email: email, Username: username, Role: u.Role}, nil
+	}
+	pair, err := uc.tu.Issue(ctx, u)
+	if err != nil {
+		return nil, err
+	}
 	return &UserLogin{
-		UserID:   u.UserID,
-		Email:    email,
-		Username: username,
-		Token:    uc.generateToken(u),
+		UserID:       u.UserID,
+		Email:        email,
+		Username:     username,
+		Token:        pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+		Role:         u.Role,
 	}, nil
 }
 
-// 登录
-func (uc *UserUsecase) Login(ctx context.Context, email, passwd string) (*UserLogin, error) {
-	if len(email) == 0 {
-		return nil, errors.New(422, "email", "cannot empty")
+// 记录登录失败, 计数失败不影响返回原错误
+func (uc *UserUsecase) loginFailed(ctx context.Context, email, ip string) {
+	if err := uc.guard.Fail(ctx, email, ip); err != nil {
+		uc.log.Errorf("record login failure: %v", err)
+	}
+}
+
+// 登录成功后升级密码哈希, 失败不影响本次登录
+func (uc *UserUsecase) rehash(ctx context.Context, u *User, passwd string) {
+	hash, err := uc.pm.Hash(passwd)
+	if err != nil {
+		uc.log.Errorf("rehash password: %v", err)
+		return
+	}
+	if _, err := uc.ur.UpdateUser(ctx, u.UserID, &User{PasswdHash: hash}); err != nil {
+		uc.log.Errorf("rehash password: %v", err)
+		return
+	}
+	u.PasswdHash = hash
+}
+
+// 登录, ip 为客户端地址, 用于失败次数限制
+func (uc *UserUsecase) Login(ctx context.Context, email, passwd, ip string) (*UserLogin, error) {
+	if err := uc.guard.Check(ctx, email, ip); err != nil {
+		return nil, err
 	}
+	// 账号不存在与密码错误返回相同的错误, 且同样校验一次密码, 避免枚举账号
 	u, err := uc.ur.GetUserByEmail(ctx, email)
+	if errors.IsNotFound(err) {
+		uc.pm.Verify("", passwd)
+		uc.loginFailed(ctx, email, ip)
+		return nil, errors.Forbidden("user", "account or password error")
+	}
 	if err != nil {
 		return nil, err
 	}
-	if !verifyPassword(u.PasswdHash, passwd) {
-		return nil, errors.Forbidden("user", "account or passwrod error")
+	if !uc.pm.Verify(u.PasswdHash, passwd) {
+		uc.loginFailed(ctx, email, ip)
+		return nil, errors.Forbidden("user", "account or password error")
+	}
+	// 哈希算法或参数已升级, 用明文密码重新生成
+	if uc.pm.NeedsRehash(u.PasswdHash) {
+		uc.rehash(ctx, u, passwd)
+	}
+	if uc.au.RequireVerification() && !u.EmailVerified {
+		return nil, errors.Forbidden("email", "not verified")
+	}
+	if u.TOTPEnabled {
+		token, err := uc.au.issue(ctx, u, PurposeMFALogin)
+		if err != nil {
+			return nil, err
+		}
+		return &UserLogin{
+			UserID:   u.UserID,
+			Username: u.Username,
+			Email:    u.Email,
+			MFAToken: token,
+		}, nil
+	}
+	// 开启两步验证时在 LoginMFA 成功后才清除失败计数
+	if err := uc.guard.Succeed(ctx, u.Email); err != nil {
+		return nil, err
+	}
+	pair, err := uc.tu.Issue(ctx, u)
+	if err != nil {
+		return nil, err
 	}
 	return &UserLogin{
-		UserID:   u.UserID,
-		Username: u.Username,
-		Email:    u.Email,
-		Token:    uc.generateToken(u),
+		UserID:        u.UserID,
+		Username:      u.Username,
+		Email:         u.Email,
+		Token:         pair.AccessToken,
+		RefreshToken:  pair.RefreshToken,
+		Role:          u.Role,
+		EmailVerified: u.EmailVerified,
 	}, nil
 }
 
 // 获取当前登录用户
 func (uc *UserUsecase) GetCurrentUser(ctx context.Context) (*UserLogin, error) {
-	// 通过jwt token解密得到username信息
-	loginUser := uc.ParseLoginInfo(ctx)
-	// 通过username查询用户
-	u, err := uc.ur.GetUserByUserName(ctx, loginUser.Username)
+	// 通过jwt token解密得到用户信息
+	loginUser, err := uc.ParseLoginInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 通过用户ID查询用户, 用户名修改后旧 token 仍可使用
+	u, err := uc.ur.GetUserByUserID(ctx, loginUser.UserID)
 	if err != nil {
 		return nil, errors.NotFound("user", "not exist")
 	}
 	return &UserLogin{
-		UserID:   u.UserID,
-		Username: u.Username,
-		Email:    u.Email,
-		Token:    uc.generateToken(u),
-		Bio:      u.Bio,
-		Image:    u.Image,
+		UserID:        u.UserID,
+		Username:      u.Username,
+		Email:         u.Email,
+		Token:         loginUser.Token,
+		Bio:           u.Bio,
+		Image:         u.Image,
+		Role:          u.Role,
+		EmailVerified: u.EmailVerified,
 	}, nil
 }
 
 // 更新用户简介
 func (uc *UserUsecase) UpdateUser(ctx context.Context, uur *v1.UpdateUserRequest) (*UserLogin, error) {
-	loginUser := uc.ParseLoginInfo(ctx)
+	loginUser, err := uc.ParseLoginInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 修改邮箱时检查是否已被其他用户使用
+	emailChanged := false
+	if uur.User.Email != "" {
+		current, err := uc.ur.GetUserByUserID(ctx, loginUser.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if uur.User.Email != current.Email {
+			if uc.ur.VerifyUserExistByEmail(ctx, uur.User.Email) {
+				return nil, errors.ParamInvalid("email", "has exist")
+			}
+			emailChanged = true
+		}
+	}
 	u := &User{
 		Email:    uur.User.Email,
 		Username: uur.User.Username,
@@ -169,19 +268,32 @@ func (uc *UserUsecase) UpdateUser(ctx context.Context, uur *v1.UpdateUserRequest
 		Image:    uur.User.Image,
 	}
 	if uur.User.Password != "" {
-		u.PasswdHash = hashpassword(uur.User.Password)
+		if err := uc.pm.Validate(uur.User.Password); err != nil {
+			return nil, err
+		}
+		if u.PasswdHash, err = uc.pm.Hash(uur.User.Password); err != nil {
+			return nil, err
+		}
 	}
-	u, err := uc.ur.UpdateUser(ctx, loginUser.UserID, u)
+	u, err = uc.ur.UpdateUser(ctx, loginUser.UserID, u)
 	if err != nil {
 		return nil, err
 	}
+	// 新邮箱需重新验证, 发送失败不影响修改, 用户可重新请求发送
+	if emailChanged {
+		if err := uc.au.SendVerificationEmail(ctx, u); err != nil {
+			uc.log.Errorf("send verification email to %s: %v", u.Email, err)
+		}
+	}
 	return &UserLogin{
-		UserID:   u.UserID,
-		Username: u.Username,
-		Email:    u.Email,
-		Token:    uc.generateToken(u),
-		Bio:      u.Bio,
-		Image:    u.Image,
+		UserID:        u.UserID,
+		Username:      u.Username,
+		Email:         u.Email,
+		Token:         loginUser.Token,
+		Bio:           u.Bio,
+		Image:         u.Image,
+		Role:          u.Role,
+		EmailVerified: u.EmailVerified,
 	}, nil
 }
 
@@ -192,26 +304,37 @@ func (uc *UserUsecase) GetProfile(ctx context.Context, userId int) (*Author, err
 	if err != nil {
 		return nil, err
 	}
-	return &Author{
-		UserID:    u.UserID,
-		Username:  u.Username,
-		Following: true,
-		Bio:       u.Bio,
-		Image:     u.Image,
-	}, nil
+	author := &Author{
+		UserID:   u.UserID,
+		Username: u.Username,
+		Bio:      u.Bio,
+		Image:    u.Image,
+	}
+	// 登录时返回当前用户是否已关注
+	if loginUser, ok := auth.FromContext(ctx); ok {
+		following, err := uc.pr.GetFollowing(ctx, loginUser.UserID, []int{userId})
+		if err != nil {
+			return nil, err
+		}
+		author.Following = following[userId]
+	}
+	return author, nil
 }
 
 // 关注用户
 func (uc *UserUsecase) FollowUser(ctx context.Context, userId int) (*Author, error) {
 	// 获取当前用户
-	loginUser := uc.ParseLoginInfo(ctx)
+	loginUser, err := uc.ParseLoginInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
 	// 获取用户信息
 	u, err := uc.ur.GetUserByUserID(ctx, userId)
 	if err != nil {
 		return nil, err
 	}
 	if loginUser.UserID == userId {
-		return nil, errors.New(422, "user", "cannot follow self")
+		return nil, errors.ParamInvalid("user", "cannot follow self")
 	}
 	// 获取关注信息
 	_, err = uc.pr.FollowUser(ctx, loginUser.UserID, userId)
@@ -230,7 +353,10 @@ func (uc *UserUsecase) FollowUser(ctx context.Context, userId int) (*Author, err
 // 取消关注用户
 func (uc *UserUsecase) UnFollowUser(ctx context.Context, userId int) (*Author, error) {
 	// 获取当前用户
-	loginUser := uc.ParseLoginInfo(ctx)
+	loginUser, err := uc.ParseLoginInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
 	// 获取用户信息
 	u, err := uc.ur.GetUserByUserID(ctx, userId)
 	if err != nil {
@@ -248,3 +374,36 @@ func (uc *UserUsecase) UnFollowUser(ctx context.Context, userId int) (*Author, e
 		Image:     u.Image,
 	}, nil
 }
+
+// 修改用户角色, 仅管理员可用(由 RoleAuth 中间件校验); 修改后该用户需重新登录
+func (uc *UserUsecase) SetUserRole(ctx context.Context, username, role string) (*User, error) {
+	loginUser, err := uc.ParseLoginInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !auth.ValidRole(role) {
+		return nil, errors.ParamInvalid("role", "invalid role")
+	}
+	u, err := uc.ur.GetUserByUserName(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	// 防止管理员误操作撤销自己的权限
+	if u.UserID == loginUser.UserID {
+		return nil, errors.ParamInvalid("role", "cannot change own role")
+	}
+	if err := uc.ur.SetUserRole(ctx, u.UserID, role); err != nil {
+		return nil, err
+	}
+	u.Role = role
+	return u, nil
+}
+
+// 解除账号的登录锁定及其失败来源 IP 的锁定, 是账号被锁定后的恢复途径, 仅管理员可用(由 RoleAuth 中间件校验)
+func (uc *UserUsecase) UnlockUser(ctx context.Context, username string) error {
+	u, err := uc.ur.GetUserByUserName(ctx, username)
+	if err != nil {
+		return err
+	}
+	return uc.guard.Unlock(ctx, u.Email)
+}
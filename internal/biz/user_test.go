@@ -1,10 +1,150 @@
 package biz
 
 import (
+	"context"
+	v1 "demo/api/realworld/v1"
+	"demo/internal/conf"
+	"demo/internal/pkg/middleware/auth"
 	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
 )
 
+// 以 map 保存用户的 UserRepo, 只实现用到的方法
+type userStub struct {
+	UserRepo
+	users map[int]*User
+}
+
+func (r *userStub) CreateUser(ctx context.Context, u *User) error {
+	if r.VerifyUserExistByEmail(ctx, u.Email) {
+		return errors.Conflict("email", "has exist")
+	}
+	u.UserID = len(r.users) + 1
+	cp := *u
+	r.users[u.UserID] = &cp
+	return nil
+}
+
+func (r *userStub) find(match func(u *User) bool) (*User, error) {
+	for _, u := range r.users {
+		if match(u) {
+			cp := *u
+			return &cp, nil
+		}
+	}
+	return nil, errors.NotFound("user", "not found")
+}
+
+func (r *userStub) GetUserByEmail(ctx context.Context, email string) (*User, error) {
+	return r.find(func(u *User) bool { return u.Email == email })
+}
+
+func (r *userStub) VerifyUserExistByEmail(ctx context.Context, email string) bool {
+	_, err := r.GetUserByEmail(ctx, email)
+	return err == nil
+}
+
+func (r *userStub) GetUserByUserID(ctx context.Context, id int) (*User, error) {
+	return r.find(func(u *User) bool { return u.UserID == id })
+}
+
+func (r *userStub) GetUserByUserName(ctx context.Context, name string) (*User, error) {
+	return r.find(func(u *User) bool { return u.Username == name })
+}
+
+func (r *userStub) GetUsersByUserIDs(ctx context.Context, ids []int) ([]*User, error) {
+	us := []*User{}
+	for _, id := range ids {
+		if u, ok := r.users[id]; ok {
+			us = append(us, u)
+		}
+	}
+	return us, nil
+}
+
+// 与 data 层一致, 只更新非零值字段, 邮箱修改后需重新验证
+func (r *userStub) UpdateUser(ctx context.Context, userId int, bu *User) (*User, error) {
+	u, ok := r.users[userId]
+	if !ok {
+		return nil, errors.NotFound("user", "not found")
+	}
+	if bu.Email != "" && bu.Email != u.Email {
+		u.Email, u.EmailVerified = bu.Email, false
+	}
+	if bu.Username != "" {
+		u.Username = bu.Username
+	}
+	if bu.Bio != "" {
+		u.Bio = bu.Bio
+	}
+	if bu.Image != "" {
+		u.Image = bu.Image
+	}
+	if bu.PasswdHash != "" {
+		u.PasswdHash = bu.PasswdHash
+	}
+	cp := *u
+	return &cp, nil
+}
+
+func (r *userStub) SetUserRole(ctx context.Context, userId int, role string) error {
+	u, ok := r.users[userId]
+	if !ok {
+		return errors.NotFound("user", "not found")
+	}
+	u.Role = role
+	return nil
+}
+
+func (r *userStub) MarkEmailVerified(ctx context.Context, userId int) error {
+	if u, ok := r.users[userId]; ok {
+		u.EmailVerified = true
+	}
+	return nil
+}
+
 func TestHashPassword(t *testing.T) {
 	// Hashpassword("123456")
 
 }
+
+func TestUpdateUser(t *testing.T) {
+	key, _ := auth.NewHMACKey("", "HS256", []byte("secret"))
+	ks, _ := auth.NewKeySet(key)
+	ur := &userStub{users: map[int]*User{
+		1: {UserID: 1, Email: "a@b.c", Username: "a", Role: auth.RoleAdmin},
+		2: {UserID: 2, Email: "b@b.c", Username: "b", Role: auth.RoleUser},
+	}}
+	mailer := &mailStub{}
+	au := NewAccountUsecase(ur, &actionTokenStub{tokens: map[string]*ActionToken{}}, nil, nil, txStub{}, mailer, ks, &conf.Mail{}, nil, log.DefaultLogger)
+	uc := NewUserUseCase(ur, nil, nil, au, nil, nil, log.DefaultLogger)
+	// token 中仍是旧角色, 返回值应以库中为准
+	userCtx := auth.NewContext(context.Background(), auth.LoginUser{UserID: 1, Role: auth.RoleUser})
+	ul, err := uc.UpdateUser(userCtx, &v1.UpdateUserRequest{User: &v1.UpdateUserRequest_User{Bio: "bio"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ul.Role != auth.RoleAdmin || ul.Bio != "bio" || ul.Email != "a@b.c" || ul.Username != "a" {
+		t.Fatalf("unexpected update reply: %+v", ul)
+	}
+
+	// 邮箱已被其他用户使用
+	if _, err := uc.UpdateUser(userCtx, &v1.UpdateUserRequest{User: &v1.UpdateUserRequest_User{Email: "b@b.c"}}); errors.Code(err) != 422 {
+		t.Fatalf("expected taken email to be rejected, got %v", err)
+	}
+	// 邮箱未修改时不重新验证
+	ur.users[1].EmailVerified = true
+	if ul, err := uc.UpdateUser(userCtx, &v1.UpdateUserRequest{User: &v1.UpdateUserRequest_User{Email: "a@b.c"}}); err != nil || !ul.EmailVerified || len(mailer.mails) != 0 {
+		t.Fatalf("expected unchanged email to stay verified, got %+v %v", ul, err)
+	}
+	// 修改邮箱后需重新验证, 并向新邮箱发送验证邮件
+	ul, err = uc.UpdateUser(userCtx, &v1.UpdateUserRequest{User: &v1.UpdateUserRequest_User{Email: "c@b.c"}})
+	if err != nil || ul.EmailVerified {
+		t.Fatalf("expected changed email to be unverified, got %+v %v", ul, err)
+	}
+	if len(mailer.mails) != 1 || mailer.mails[0].To != "c@b.c" {
+		t.Fatalf("expected verification email to new address, got %+v", mailer.mails)
+	}
+}
@@ -0,0 +1,74 @@
+package data
+
+import (
+	"context"
+	"demo/internal/biz"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// 邮箱验证、重置密码等一次性 token 的使用记录
+type ActionToken struct {
+	ID        int    `gorm:"primaryKey;autoIncrement"`
+	CreatedAt int    `gorm:"not null;comment:创建时间" json:"created_at"`
+	Jti       string `gorm:"type:varchar(64);not null;uniqueIndex;comment:token ID" json:"jti"`
+	UserID    int    `gorm:"not null;index;comment:用户ID" json:"user_id"`
+	Purpose   string `gorm:"type:varchar(32);not null;comment:用途" json:"purpose"`
+	ExpiresAt int64  `gorm:"not null;comment:过期时间" json:"expires_at"`
+	UsedAt    int64  `gorm:"not null;default:0;comment:使用时间, 失效的 token 同样记录" json:"used_at"`
+}
+
+type actionTokenRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewActionTokenRepo(data *Data, logger log.Logger) biz.ActionTokenRepo {
+	return &actionTokenRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *actionTokenRepo) CreateActionToken(ctx context.Context, t *biz.ActionToken) error {
+	po := &ActionToken{
+		Jti:       t.Jti,
+		UserID:    t.UserID,
+		Purpose:   t.Purpose,
+		ExpiresAt: t.ExpiresAt.Unix(),
+	}
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		return err
+	}
+	t.ID, t.CreatedAt = po.ID, time.Unix(int64(po.CreatedAt), 0)
+	return nil
+}
+
+func (r *actionTokenRepo) UseActionToken(ctx context.Context, jti, purpose string) (bool, error) {
+	now := time.Now().Unix()
+	rv := r.data.DB(ctx).Model(&ActionToken{}).
+		Where("jti=? and purpose=? and used_at=0 and expires_at>?", jti, purpose, now).
+		Update("used_at", now)
+	return rv.RowsAffected > 0, rv.Error
+}
+
+func (r *actionTokenRepo) InvalidateActionTokens(ctx context.Context, userId int, purpose string) error {
+	return r.data.DB(ctx).Model(&ActionToken{}).
+		Where("user_id=? and purpose=? and used_at=0", userId, purpose).
+		Update("used_at", time.Now().Unix()).Error
+}
+
+func (r *actionTokenRepo) LastActionTokenIssuedAt(ctx context.Context, userId int, purpose string) (time.Time, error) {
+	po := new(ActionToken)
+	rv := r.data.DB(ctx).Select("created_at").Where("user_id=? and purpose=?", userId, purpose).Order("id desc").Take(po)
+	if errors.Is(rv.Error, gorm.ErrRecordNotFound) {
+		return time.Time{}, nil
+	}
+	if rv.Error != nil {
+		return time.Time{}, rv.Error
+	}
+	return time.Unix(int64(po.CreatedAt), 0), nil
+}
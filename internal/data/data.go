@@ -1,17 +1,25 @@
 package data
 
 import (
+	"context"
+	"demo/internal/biz"
 	"demo/internal/conf"
+	"errors"
+	"fmt"
 
 	"github.com/go-kratos/kratos/v2/log"
+	mysqldriver "github.com/go-sql-driver/mysql"
 	"github.com/google/wire"
+	"github.com/jackc/pgconn"
+	"github.com/mattn/go-sqlite3"
 	"gorm.io/driver/mysql"
-
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewDB, NewUserRepo, NewProfileRepo, NewArticleRepo, NewCommentRepo, NewTagRepo)
+var ProviderSet = wire.NewSet(NewData, NewDB, NewTransaction, NewUserRepo, NewProfileRepo, NewArticleRepo, NewCommentRepo, NewTagRepo, NewFavoriteRepo, NewTokenRepo, NewActionTokenRepo, NewMailer, NewMFARepo, NewLoginAttemptRepo, NewIdentityRepo, NewPersonalTokenRepo, NewSessionRepo)
 
 // Data .
 type Data struct {
@@ -26,21 +34,99 @@ func NewData(c *conf.Data, logger log.Logger, db *gorm.DB) (*Data, func(), error
 	return &Data{db: db}, cleanup, nil
 }
 
-func NewDB(c *conf.Data) *gorm.DB {
-	db, err := gorm.Open(mysql.Open(c.Database.Dsn), &gorm.Config{
+type contextTxKey struct{}
+
+// DB 返回 ctx 中绑定的事务, 不在事务中时返回普通连接
+func (d *Data) DB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value(contextTxKey{}).(*gorm.DB); ok {
+		return tx
+	}
+	return d.db.WithContext(ctx)
+}
+
+// InTx 在事务中执行 fn, 已在事务中时使用 savepoint 嵌套
+func (d *Data) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return d.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, contextTxKey{}, tx))
+	})
+}
+
+func NewTransaction(d *Data) biz.Transaction {
+	return d
+}
+
+// NewDB 连接数据库并检查迁移状态, 存在未执行或失败的迁移时拒绝启动
+func NewDB(c *conf.Data, logger log.Logger) (*gorm.DB, error) {
+	db, err := OpenDB(c)
+	if err != nil {
+		return nil, err
+	}
+	l := log.NewHelper(logger)
+	if c.Database.SkipMigrationCheck {
+		l.Warn("database migration check skipped")
+		return db, nil
+	}
+	m, err := NewMigrator(db)
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+	if c.Database.AutoMigrate {
+		applied, err := m.Up(ctx, 0)
+		for _, mig := range applied {
+			l.Infof("applied migration %d_%s", mig.Version, mig.Name)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := m.Check(ctx); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// OpenDB 仅连接数据库, 不检查迁移状态
+func OpenDB(c *conf.Data) (*gorm.DB, error) {
+	dialector, err := newDialector(c.Database)
+	if err != nil {
+		return nil, err
+	}
+	db, err := gorm.Open(dialector, &gorm.Config{
 		DisableForeignKeyConstraintWhenMigrating: true,
 	})
 	if err != nil {
-		panic("failed to connect database")
+		return nil, fmt.Errorf("failed to connect database: %w", err)
+	}
+	return db, nil
+}
+
+// 根据配置的 driver 选择数据库方言, 默认 mysql
+func newDialector(c *conf.Data_Database) (gorm.Dialector, error) {
+	switch c.Driver {
+	case "", "mysql":
+		return mysql.Open(c.Dsn), nil
+	case "sqlite", "sqlite3":
+		return sqlite.Open(c.Dsn), nil
+	case "postgres", "postgresql":
+		return postgres.Open(c.Dsn), nil
 	}
-	InitDB(db)
-	return db
+	return nil, fmt.Errorf("unsupported database driver: %s", c.Driver)
 }
 
-func InitDB(db *gorm.DB) {
-	if err := db.Set("gorm:table_options", "ENGINE=InnoDB").
-		Set("gorm:table_options", "CHARSET=UTF8").
-		AutoMigrate(&User{}, &Follow{}, &Article{}, &Tag{}, &Comment{}); err != nil {
-		panic("failed to connect database")
+// isDuplicateKey 是否为违反唯一索引的错误
+func isDuplicateKey(err error) bool {
+	var myErr *mysqldriver.MySQLError
+	if errors.As(err, &myErr) {
+		return myErr.Number == 1062
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == "23505"
+	}
+	var liteErr sqlite3.Error
+	if errors.As(err, &liteErr) {
+		return liteErr.ExtendedCode == sqlite3.ErrConstraintUnique || liteErr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey
 	}
+	return false
 }
@@ -1,9 +1,136 @@
 package data
 
 import (
+	"context"
+	"demo/internal/biz"
+	"demo/internal/conf"
+	"demo/internal/pkg/middleware/auth"
+	"errors"
+	"os"
 	"testing"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
 )
 
 func TestNewDB(t *testing.T) {
-	NewDB(nil)
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Dsn: "file::memory:?cache=shared"}}
+	if _, err := NewDB(c, log.NewStdLogger(os.Stdout)); err != ErrPendingMigrations {
+		t.Fatalf("expected pending migrations, got %v", err)
+	}
+	c.Database.AutoMigrate = true
+	if _, err := NewDB(c, log.NewStdLogger(os.Stdout)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestInTx(t *testing.T) {
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Dsn: "file:" + t.Name() + "?mode=memory&cache=shared", AutoMigrate: true}}
+	db, err := NewDB(c, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &Data{db: db}
+	tr := NewTagRepo(d, log.DefaultLogger)
+	ctx := context.Background()
+	rollback := errors.New("rollback")
+	err = d.InTx(ctx, func(ctx context.Context) error {
+		if _, err := tr.Create(ctx, &biz.Article{ID: 1, TagList: []string{"go"}}); err != nil {
+			return err
+		}
+		return rollback
+	})
+	if err != rollback {
+		t.Fatalf("expected rollback error, got %v", err)
+	}
+	ar, err := tr.Get(ctx, &biz.Article{}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ar.TagList) != 0 {
+		t.Fatalf("tags should be rolled back, got %v", ar.TagList)
+	}
+}
+
+func TestSoftDelete(t *testing.T) {
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Dsn: "file:" + t.Name() + "?mode=memory&cache=shared", AutoMigrate: true}}
+	db, err := NewDB(c, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &Data{db: db}
+	ar := NewArticleRepo(d, log.DefaultLogger)
+	pr := NewProfileRepo(d, log.DefaultLogger)
+	ctx := context.Background()
+
+	a, err := ar.Create(ctx, &biz.Article{Title: "t", Slug: "t", Author: biz.Author{UserID: 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ar.Delete(ctx, a.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ar.Get(ctx, a.ID); !kerrors.IsNotFound(err) {
+		t.Fatalf("deleted article should not be found, got %v", err)
+	}
+	if exist, _ := ar.SlugExists(ctx, "t", 0); !exist {
+		t.Fatal("deleted article should keep its slug")
+	}
+	if err := ar.Restore(ctx, a.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ar.Get(ctx, a.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := ar.Purge(ctx, a.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ar.GetUnscoped(ctx, a.ID); !kerrors.IsNotFound(err) {
+		t.Fatalf("purged article should not be found, got %v", err)
+	}
+
+	// 取消关注后不再出现在关注列表, 可再次关注
+	pr.FollowUser(ctx, 1, 2)
+	if ok, err := pr.UnfollowUser(ctx, 1, 2); !ok || err != nil {
+		t.Fatalf("unfollow: %v %v", ok, err)
+	}
+	if following, _ := pr.GetFollowing(ctx, 1, []int{2}); following[2] {
+		t.Fatal("unfollowed user should not be followed")
+	}
+	pr.FollowUser(ctx, 1, 2)
+	if following, _ := pr.GetFollowing(ctx, 1, []int{2}); !following[2] {
+		t.Fatal("user should be followed again")
+	}
+}
+
+func TestBootstrapAdmin(t *testing.T) {
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Dsn: "file:" + t.Name() + "?mode=memory&cache=shared", AutoMigrate: true}}
+	db, err := NewDB(c, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &Data{db: db}
+	ur := NewUserRepo(d, log.DefaultLogger)
+	ctx := context.Background()
+	for _, name := range []string{"a", "b"} {
+		if err := ur.CreateUser(ctx, &biz.User{Email: name + "@b.c", Username: name, Role: auth.RoleUser}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := BootstrapAdmin(ctx, d.db, "x@b.c"); err == nil {
+		t.Fatal("expected error for unknown email")
+	}
+	if err := BootstrapAdmin(ctx, d.db, "a@b.c"); err != nil {
+		t.Fatal(err)
+	}
+	u, err := ur.GetUserByEmail(ctx, "a@b.c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Role != "admin" {
+		t.Fatalf("expected admin role, got %q", u.Role)
+	}
+	if err := BootstrapAdmin(ctx, d.db, "b@b.c"); err == nil {
+		t.Fatal("expected error when an admin already exists")
+	}
 }
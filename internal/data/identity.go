@@ -0,0 +1,167 @@
+package data
+
+import (
+	"context"
+	"demo/internal/biz"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// 用户绑定的第三方身份
+type LinkedIdentity struct {
+	ID        int    `gorm:"primaryKey;autoIncrement"`
+	CreatedAt int    `gorm:"not null;comment:创建时间" json:"created_at"`
+	UserID    int    `gorm:"not null;uniqueIndex:idx_linked_identities_user_provider;comment:用户ID" json:"user_id"`
+	Provider  string `gorm:"type:varchar(32);not null;uniqueIndex:idx_linked_identities_provider_subject;uniqueIndex:idx_linked_identities_user_provider;comment:身份提供方" json:"provider"`
+	Subject   string `gorm:"type:varchar(191);not null;uniqueIndex:idx_linked_identities_provider_subject;comment:提供方的用户ID(sub)" json:"subject"`
+	Email     string `gorm:"type:varchar(191);not null;default:'';comment:提供方返回的邮箱" json:"email"`
+}
+
+// 发起 OpenID Connect 登录时保存的 state, 回调时删除
+type OidcState struct {
+	ID        int    `gorm:"primaryKey;autoIncrement"`
+	CreatedAt int    `gorm:"not null;comment:创建时间" json:"created_at"`
+	State     string `gorm:"type:varchar(64);not null;uniqueIndex;comment:state 参数" json:"state"`
+	Provider  string `gorm:"type:varchar(32);not null;comment:身份提供方" json:"provider"`
+	Verifier  string `gorm:"type:varchar(128);not null;comment:PKCE code_verifier" json:"-"`
+	Nonce     string `gorm:"type:varchar(64);not null;comment:ID token nonce" json:"-"`
+	UserID    int    `gorm:"not null;default:0;comment:绑定账号时的当前用户" json:"user_id"`
+	ExpiresAt int64  `gorm:"not null;comment:过期时间" json:"expires_at"`
+}
+
+type identityRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewIdentityRepo(data *Data, logger log.Logger) biz.IdentityRepo {
+	return &identityRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func toLinkedIdentity(po *LinkedIdentity) *biz.LinkedIdentity {
+	return &biz.LinkedIdentity{
+		ID:        po.ID,
+		UserID:    po.UserID,
+		Provider:  po.Provider,
+		Subject:   po.Subject,
+		Email:     po.Email,
+		CreatedAt: time.Unix(int64(po.CreatedAt), 0),
+	}
+}
+
+func toOIDCState(po *OidcState) *biz.OIDCState {
+	return &biz.OIDCState{
+		State:     po.State,
+		Provider:  po.Provider,
+		Verifier:  po.Verifier,
+		Nonce:     po.Nonce,
+		UserID:    po.UserID,
+		ExpiresAt: time.Unix(po.ExpiresAt, 0),
+	}
+}
+
+func (r *identityRepo) GetIdentity(ctx context.Context, provider, subject string) (*biz.LinkedIdentity, error) {
+	po := new(LinkedIdentity)
+	rv := r.data.DB(ctx).Where("provider=? and subject=?", provider, subject).First(po)
+	if errors.Is(rv.Error, gorm.ErrRecordNotFound) {
+		return nil, errors.NotFound("identity", "not found")
+	}
+	if rv.Error != nil {
+		return nil, rv.Error
+	}
+	return toLinkedIdentity(po), nil
+}
+
+func (r *identityRepo) ListIdentities(ctx context.Context, userId int) ([]*biz.LinkedIdentity, error) {
+	var pos []*LinkedIdentity
+	if err := r.data.DB(ctx).Where("user_id=?", userId).Order("id").Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	rv := make([]*biz.LinkedIdentity, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toLinkedIdentity(po))
+	}
+	return rv, nil
+}
+
+func (r *identityRepo) CreateIdentity(ctx context.Context, li *biz.LinkedIdentity) error {
+	po := &LinkedIdentity{
+		UserID:   li.UserID,
+		Provider: li.Provider,
+		Subject:  li.Subject,
+		Email:    li.Email,
+	}
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		return err
+	}
+	li.ID = po.ID
+	li.CreatedAt = time.Unix(int64(po.CreatedAt), 0)
+	return nil
+}
+
+func (r *identityRepo) DeleteIdentity(ctx context.Context, userId int, provider string) (bool, error) {
+	rv := r.data.DB(ctx).Where("user_id=? and provider=?", userId, provider).Delete(&LinkedIdentity{})
+	return rv.RowsAffected > 0, rv.Error
+}
+
+// 保存 state 时顺带清理已过期的记录
+func (r *identityRepo) CreateOIDCState(ctx context.Context, s *biz.OIDCState) error {
+	db := r.data.DB(ctx)
+	if err := db.Where("expires_at<?", time.Now().Unix()).Delete(&OidcState{}).Error; err != nil {
+		return err
+	}
+	return db.Create(&OidcState{
+		State:     s.State,
+		Provider:  s.Provider,
+		Verifier:  s.Verifier,
+		Nonce:     s.Nonce,
+		UserID:    s.UserID,
+		ExpiresAt: s.ExpiresAt.Unix(),
+	}).Error
+}
+
+func (r *identityRepo) GetOIDCState(ctx context.Context, state string) (*biz.OIDCState, error) {
+	po, err := r.findOIDCState(ctx, state)
+	if po == nil || err != nil {
+		return nil, err
+	}
+	return toOIDCState(po), nil
+}
+
+// 以删除成功为准, 并发回调时只有一个请求能取到
+func (r *identityRepo) TakeOIDCState(ctx context.Context, state string) (*biz.OIDCState, error) {
+	po, err := r.findOIDCState(ctx, state)
+	if po == nil || err != nil {
+		return nil, err
+	}
+	rv := r.data.DB(ctx).Where("id=?", po.ID).Delete(&OidcState{})
+	if rv.Error != nil {
+		return nil, rv.Error
+	}
+	if rv.RowsAffected == 0 {
+		return nil, nil
+	}
+	return toOIDCState(po), nil
+}
+
+// 不存在或已过期时返回 nil
+func (r *identityRepo) findOIDCState(ctx context.Context, state string) (*OidcState, error) {
+	if state == "" {
+		return nil, nil
+	}
+	po := new(OidcState)
+	rv := r.data.DB(ctx).Where("state=? and expires_at>?", state, time.Now().Unix()).First(po)
+	if errors.Is(rv.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if rv.Error != nil {
+		return nil, rv.Error
+	}
+	return po, nil
+}
@@ -0,0 +1,67 @@
+package data
+
+import (
+	"context"
+	"demo/internal/biz"
+	"demo/internal/conf"
+	"testing"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestIdentityRepo(t *testing.T) {
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Dsn: "file:" + t.Name() + "?mode=memory&cache=shared", AutoMigrate: true}}
+	db, err := NewDB(c, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &Data{db: db}
+	ir := NewIdentityRepo(d, log.DefaultLogger)
+	ctx := context.Background()
+
+	if err := ir.CreateIdentity(ctx, &biz.LinkedIdentity{UserID: 1, Provider: "test", Subject: "s1"}); err != nil {
+		t.Fatal(err)
+	}
+	// 同一身份只能绑定一个用户, 每个用户在同一提供方只能绑定一个身份
+	for _, li := range []*biz.LinkedIdentity{{UserID: 2, Provider: "test", Subject: "s1"}, {UserID: 1, Provider: "test", Subject: "s2"}} {
+		if err := ir.CreateIdentity(ctx, li); err == nil {
+			t.Fatalf("expected duplicate identity to be rejected: %+v", li)
+		}
+	}
+	if li, err := ir.GetIdentity(ctx, "test", "s1"); err != nil || li.UserID != 1 {
+		t.Fatalf("unexpected identity: %+v %v", li, err)
+	}
+	if ok, err := ir.DeleteIdentity(ctx, 1, "test"); !ok || err != nil {
+		t.Fatalf("delete: %v %v", ok, err)
+	}
+	if _, err := ir.GetIdentity(ctx, "test", "s1"); !kerrors.IsNotFound(err) {
+		t.Fatalf("expected deleted identity to be not found, got %v", err)
+	}
+
+	// state 只能取出一次, 过期后取不到
+	for _, s := range []*biz.OIDCState{{State: "valid", ExpiresAt: time.Now().Add(time.Minute)}, {State: "expired", ExpiresAt: time.Now().Add(-time.Minute)}} {
+		s.Provider = "test"
+		if err := ir.CreateOIDCState(ctx, s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// 读取不会删除 state
+	for i := 0; i < 2; i++ {
+		if s, err := ir.GetOIDCState(ctx, "valid"); err != nil || s == nil || s.Provider != "test" {
+			t.Fatalf("unexpected state: %+v %v", s, err)
+		}
+	}
+	if s, err := ir.GetOIDCState(ctx, "expired"); err != nil || s != nil {
+		t.Fatalf("expected expired state to be hidden, got %+v %v", s, err)
+	}
+	if s, err := ir.TakeOIDCState(ctx, "valid"); err != nil || s == nil || s.Provider != "test" {
+		t.Fatalf("unexpected state: %+v %v", s, err)
+	}
+	for _, state := range []string{"valid", "expired", ""} {
+		if s, err := ir.TakeOIDCState(ctx, state); err != nil || s != nil {
+			t.Fatalf("%q: expected no state, got %+v %v", state, s, err)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"demo/internal/biz"
+	"demo/internal/conf"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// NewMailer 按配置选择 smtp 或 outbox, 未配置时使用输出到 stdout 的 outbox
+func NewMailer(c *conf.Mail, logger log.Logger) (biz.Mailer, error) {
+	switch c.GetDriver() {
+	case "", "outbox":
+		if c.GetOutboxDir() == "" {
+			log.NewHelper(logger).Warn("mail outbox is stdout, emails will not be delivered")
+			return NewOutboxMailer(c.GetFrom(), "", os.Stdout), nil
+		}
+		if err := os.MkdirAll(c.GetOutboxDir(), 0o755); err != nil {
+			return nil, err
+		}
+		return NewOutboxMailer(c.GetFrom(), c.GetOutboxDir(), nil), nil
+	case "smtp":
+		if c.GetSmtp().GetAddr() == "" {
+			return nil, fmt.Errorf("mail smtp addr is required")
+		}
+		return &smtpMailer{from: c.GetFrom(), c: c.GetSmtp()}, nil
+	}
+	return nil, fmt.Errorf("unsupported mail driver: %s", c.GetDriver())
+}
+
+// 生成 RFC 5322 格式的纯文本邮件
+func buildMessage(from string, m *biz.Mail) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", m.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", m.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	b.WriteString(strings.ReplaceAll(m.Body, "\n", "\r\n"))
+	return b.Bytes()
+}
+
+type smtpMailer struct {
+	from string
+	c    *conf.Mail_SMTP
+}
+
+func (s *smtpMailer) Send(ctx context.Context, m *biz.Mail) error {
+	var a smtp.Auth
+	if s.c.Username != "" {
+		host, _, err := net.SplitHostPort(s.c.Addr)
+		if err != nil {
+			return err
+		}
+		a = smtp.PlainAuth("", s.c.Username, s.c.Password, host)
+	}
+	return smtp.SendMail(s.c.Addr, a, s.from, []string{m.To}, buildMessage(s.from, m))
+}
+
+// OutboxMailer 不投递邮件, 写入目录(每封一个 .eml 文件)或 w, 用于本地开发与测试
+type OutboxMailer struct {
+	from string
+	dir  string
+	w    io.Writer
+
+	mu   sync.Mutex
+	sent []*biz.Mail
+}
+
+func NewOutboxMailer(from, dir string, w io.Writer) *OutboxMailer {
+	return &OutboxMailer{from: from, dir: dir, w: w}
+}
+
+func (o *OutboxMailer) Send(ctx context.Context, m *biz.Mail) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.sent = append(o.sent, m)
+	msg := buildMessage(o.from, m)
+	if o.dir != "" {
+		name := fmt.Sprintf("%d-%s.eml", time.Now().UnixNano(), strings.NewReplacer("@", "_at_", "/", "_").Replace(m.To))
+		return os.WriteFile(filepath.Join(o.dir, name), msg, 0o644)
+	}
+	if o.w != nil {
+		_, err := fmt.Fprintf(o.w, "%s\n\n", msg)
+		return err
+	}
+	return nil
+}
+
+// Sent 返回已发送的邮件
+func (o *OutboxMailer) Sent() []*biz.Mail {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return append([]*biz.Mail(nil), o.sent...)
+}
@@ -0,0 +1,87 @@
+package data
+
+import (
+	"context"
+	"demo/internal/biz"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// 两步验证恢复码, 只保存哈希
+type RecoveryCode struct {
+	ID        int    `gorm:"primaryKey;autoIncrement"`
+	CreatedAt int    `gorm:"not null;comment:创建时间" json:"created_at"`
+	UserID    int    `gorm:"not null;index;comment:用户ID" json:"user_id"`
+	CodeHash  string `gorm:"type:varchar(64);not null;comment:恢复码 sha256" json:"code_hash"`
+	UsedAt    int64  `gorm:"not null;default:0;comment:使用时间" json:"used_at"`
+}
+
+type mfaRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewMFARepo(data *Data, logger log.Logger) biz.MFARepo {
+	return &mfaRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *mfaRepo) GetTOTP(ctx context.Context, userId int) (string, bool, error) {
+	u := new(User)
+	rv := r.data.DB(ctx).Select("totp_secret", "totp_enabled_at").Where("id=?", userId).First(u)
+	if errors.Is(rv.Error, gorm.ErrRecordNotFound) {
+		return "", false, errors.NotFound("user", "not found by user id")
+	}
+	return u.TotpSecret, u.TotpEnabledAt > 0, rv.Error
+}
+
+func (r *mfaRepo) SetPendingTOTP(ctx context.Context, userId int, secret string) error {
+	return r.data.DB(ctx).Model(&User{}).Where("id=? and totp_enabled_at=0", userId).Update("totp_secret", secret).Error
+}
+
+func (r *mfaRepo) EnableTOTP(ctx context.Context, userId int) error {
+	return r.data.DB(ctx).Model(&User{}).Where("id=? and totp_secret<>''", userId).Update("totp_enabled_at", time.Now().Unix()).Error
+}
+
+func (r *mfaRepo) DisableTOTP(ctx context.Context, userId int) error {
+	db := r.data.DB(ctx)
+	err := db.Model(&User{}).Where("id=?", userId).Updates(map[string]interface{}{
+		"totp_secret":     "",
+		"totp_enabled_at": 0,
+	}).Error
+	if err != nil {
+		return err
+	}
+	return db.Where("user_id=?", userId).Delete(&RecoveryCode{}).Error
+}
+
+func (r *mfaRepo) ReplaceRecoveryCodes(ctx context.Context, userId int, hashes []string) error {
+	db := r.data.DB(ctx)
+	if err := db.Where("user_id=?", userId).Delete(&RecoveryCode{}).Error; err != nil {
+		return err
+	}
+	codes := make([]*RecoveryCode, 0, len(hashes))
+	for _, h := range hashes {
+		codes = append(codes, &RecoveryCode{UserID: userId, CodeHash: h})
+	}
+	if len(codes) == 0 {
+		return nil
+	}
+	return db.Create(codes).Error
+}
+
+func (r *mfaRepo) UseRecoveryCode(ctx context.Context, userId int, hash string) (bool, error) {
+	rv := r.data.DB(ctx).Model(&RecoveryCode{}).Where("user_id=? and code_hash=? and used_at=0", userId, hash).Update("used_at", time.Now().Unix())
+	return rv.RowsAffected > 0, rv.Error
+}
+
+// 条件更新保证并发请求中同一时间窗口只有一个成功
+func (r *mfaRepo) UseTOTPStep(ctx context.Context, userId int, step int64) (bool, error) {
+	rv := r.data.DB(ctx).Model(&User{}).Where("id=? and totp_last_step<?", userId, step).Update("totp_last_step", step)
+	return rv.RowsAffected > 0, rv.Error
+}
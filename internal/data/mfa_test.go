@@ -0,0 +1,66 @@
+package data
+
+import (
+	"context"
+	"demo/internal/biz"
+	"demo/internal/conf"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestMFARepo(t *testing.T) {
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Dsn: "file:" + t.Name() + "?mode=memory&cache=shared", AutoMigrate: true}}
+	db, err := NewDB(c, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &Data{db: db}
+	mr := NewMFARepo(d, log.DefaultLogger)
+	ctx := context.Background()
+	u := &biz.User{Email: "a@b.c", Username: "a"}
+	if err := NewUserRepo(d, log.DefaultLogger).CreateUser(ctx, u); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := mr.SetPendingTOTP(ctx, u.UserID, "secret"); err != nil {
+		t.Fatal(err)
+	}
+	if err := mr.EnableTOTP(ctx, u.UserID); err != nil {
+		t.Fatal(err)
+	}
+	// 启用后不能覆盖密钥
+	if err := mr.SetPendingTOTP(ctx, u.UserID, "other"); err != nil {
+		t.Fatal(err)
+	}
+	if secret, enabled, err := mr.GetTOTP(ctx, u.UserID); err != nil || secret != "secret" || !enabled {
+		t.Fatalf("unexpected totp: %q %v %v", secret, enabled, err)
+	}
+	// 时间窗口只能递增
+	for _, c := range []struct {
+		step int64
+		ok   bool
+	}{{10, true}, {10, false}, {9, false}, {11, true}} {
+		if ok, err := mr.UseTOTPStep(ctx, u.UserID, c.step); ok != c.ok || err != nil {
+			t.Fatalf("step %d: got %v %v, want %v", c.step, ok, err, c.ok)
+		}
+	}
+	if err := mr.ReplaceRecoveryCodes(ctx, u.UserID, []string{"a", "b"}); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := mr.UseRecoveryCode(ctx, u.UserID, "a"); !ok || err != nil {
+		t.Fatalf("use recovery code: %v %v", ok, err)
+	}
+	if ok, _ := mr.UseRecoveryCode(ctx, u.UserID, "a"); ok {
+		t.Fatal("expected used recovery code to be rejected")
+	}
+	if err := mr.DisableTOTP(ctx, u.UserID); err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := mr.UseRecoveryCode(ctx, u.UserID, "b"); ok {
+		t.Fatal("expected recovery codes to be deleted")
+	}
+	if secret, enabled, err := mr.GetTOTP(ctx, u.UserID); err != nil || secret != "" || enabled {
+		t.Fatalf("expected totp to be disabled, got %q %v %v", secret, enabled, err)
+	}
+}
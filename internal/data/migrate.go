@@ -0,0 +1,388 @@
+package data
+
+import (
+	"context"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// 迁移文件按方言分目录存放: migrations/<dialect>/<version>_<name>.<up|down>.sql
+// migrations/<dialect>/legacy/upgrade.sql 用于升级引入迁移前由 AutoMigrate 建的库, 不属于任何版本
+//
+//go:embed migrations
+var migrationFS embed.FS
+
+// ErrPendingMigrations 存在未执行的迁移
+var ErrPendingMigrations = errors.New("database has pending migrations, run `demo migrate up` first")
+
+// SchemaMigration 已执行的迁移记录
+type SchemaMigration struct {
+	Version   int    `gorm:"primaryKey;autoIncrement:false;comment:迁移版本" json:"version"`
+	Name      string `gorm:"type:varchar(255);not null;comment:迁移名称" json:"name"`
+	Checksum  string `gorm:"type:varchar(64);not null;comment:迁移文件校验和" json:"checksum"`
+	Dirty     bool   `gorm:"not null;default:false;comment:是否执行失败" json:"dirty"`
+	AppliedAt int64  `gorm:"autoCreateTime;comment:执行时间" json:"applied_at"`
+}
+
+// 只修改了注释的已发布迁移文件的旧校验和, 按 <dialect>/<version> 索引, 执行过旧文件的库仍视为一致
+var previousChecksums = map[string][]string{
+	"mysql/1":    {"52581936d4345dd1423cc3fdd8ad90a1146e7556e63b01da718a948192f01654"},
+	"postgres/1": {"39834656ae70a639e63ac5b3df09a0938244906b522bde074bdb3d8059b4d0f3"},
+	"sqlite/1":   {"d237a58e3d4fa73bb37aaf26373fc91f236c1f5ae370edc761b0e33a8c836140"},
+}
+
+// Migration 一个版本的迁移
+type Migration struct {
+	Version  int
+	Name     string
+	Up       string
+	Down     string
+	Checksum string
+	// 只修改了注释的旧版本文件的校验和
+	PreviousChecksums []string
+}
+
+// 记录的校验和是否与当前或旧版本的迁移文件一致
+func (mig *Migration) matches(checksum string) bool {
+	if checksum == mig.Checksum {
+		return true
+	}
+	for _, sum := range mig.PreviousChecksums {
+		if checksum == sum {
+			return true
+		}
+	}
+	return false
+}
+
+// MigrationStatus 迁移执行状态
+type MigrationStatus struct {
+	Version          int
+	Name             string
+	Applied          bool
+	Dirty            bool
+	AppliedAt        int64
+	ChecksumMismatch bool // 已执行的迁移文件被修改
+	Missing          bool // 已执行但当前程序中没有对应的迁移文件
+}
+
+// Migrator 基于嵌入 SQL 文件的版本化迁移
+type Migrator struct {
+	db         *gorm.DB
+	migrations []*Migration
+	// 升级引入迁移前的旧库结构的 SQL
+	legacy string
+	// 方言是否支持事务内执行 DDL, mysql 不支持, 失败时只能依赖 dirty 标记
+	transactional bool
+}
+
+// NewMigrator 加载当前数据库方言的迁移文件
+func NewMigrator(db *gorm.DB) (*Migrator, error) {
+	dialect := db.Dialector.Name()
+	migrations, err := loadMigrations(migrationFS, path.Join("migrations", dialect))
+	if err != nil {
+		return nil, err
+	}
+	for _, mig := range migrations {
+		mig.PreviousChecksums = previousChecksums[dialect+"/"+strconv.Itoa(mig.Version)]
+	}
+	legacy, err := fs.ReadFile(migrationFS, path.Join("migrations", dialect, "legacy", "upgrade.sql"))
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{
+		db:            db,
+		migrations:    migrations,
+		legacy:        string(legacy),
+		transactional: dialect != "mysql",
+	}, nil
+}
+
+// 读取目录下的迁移文件, 按版本号排序
+func loadMigrations(fsys fs.FS, dir string) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("read migrations %s: %w", dir, err)
+	}
+	byVersion := map[int]*Migration{}
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".sql") {
+			continue
+		}
+		base := strings.TrimSuffix(e.Name(), ".sql")
+		direction := path.Ext(base)
+		base = strings.TrimSuffix(base, direction)
+		parts := strings.SplitN(base, "_", 2)
+		version, err := strconv.Atoi(parts[0])
+		if err != nil || len(parts) != 2 || (direction != ".up" && direction != ".down") {
+			return nil, fmt.Errorf("invalid migration file name: %s", e.Name())
+		}
+		content, err := fs.ReadFile(fsys, path.Join(dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: parts[1]}
+			byVersion[version] = m
+		}
+		if m.Name != parts[1] {
+			return nil, fmt.Errorf("migration %d has conflicting names: %s, %s", version, m.Name, parts[1])
+		}
+		if direction == ".up" {
+			m.Up = string(content)
+			sum := sha256.Sum256(content)
+			m.Checksum = hex.EncodeToString(sum[:])
+		} else {
+			m.Down = string(content)
+		}
+	}
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up file", m.Version, m.Name)
+		}
+		migrations = append(migrations, m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// 按行拆分 SQL 语句, 以行尾分号结束一条语句, 忽略注释行
+func splitStatements(sql string) []string {
+	var stmts []string
+	var b strings.Builder
+	for _, line := range strings.Split(sql, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+		b.WriteString(line)
+		b.WriteByte('\n')
+		if strings.HasSuffix(trimmed, ";") {
+			stmts = append(stmts, strings.TrimSpace(b.String()))
+			b.Reset()
+		}
+	}
+	if rest := strings.TrimSpace(b.String()); rest != "" {
+		stmts = append(stmts, rest)
+	}
+	return stmts
+}
+
+// 创建迁移记录表
+func (m *Migrator) ensureTable(ctx context.Context) error {
+	db := m.db.WithContext(ctx)
+	if db.Dialector.Name() == "mysql" {
+		db = db.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=UTF8")
+	}
+	return db.AutoMigrate(&SchemaMigration{})
+}
+
+func (m *Migrator) applied(ctx context.Context) (map[int]*SchemaMigration, error) {
+	if err := m.ensureTable(ctx); err != nil {
+		return nil, err
+	}
+	var rows []*SchemaMigration
+	if err := m.db.WithContext(ctx).Order("version").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	applied := make(map[int]*SchemaMigration, len(rows))
+	for _, r := range rows {
+		applied[r.Version] = r
+	}
+	return applied, nil
+}
+
+// Status 返回所有迁移的执行状态, 按版本号排序
+func (m *Migrator) Status(ctx context.Context) ([]*MigrationStatus, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*MigrationStatus, 0, len(m.migrations))
+	for _, mig := range m.migrations {
+		st := &MigrationStatus{Version: mig.Version, Name: mig.Name}
+		if r, ok := applied[mig.Version]; ok {
+			st.Applied = true
+			st.Dirty = r.Dirty
+			st.AppliedAt = r.AppliedAt
+			st.ChecksumMismatch = !mig.matches(r.Checksum)
+			delete(applied, mig.Version)
+		}
+		list = append(list, st)
+	}
+	for _, r := range applied {
+		list = append(list, &MigrationStatus{
+			Version:   r.Version,
+			Name:      r.Name,
+			Applied:   true,
+			Dirty:     r.Dirty,
+			AppliedAt: r.AppliedAt,
+			Missing:   true,
+		})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Version < list[j].Version
+	})
+	return list, nil
+}
+
+// 存在 dirty、被修改或缺失的迁移时返回错误
+func checkConsistent(list []*MigrationStatus) error {
+	for _, st := range list {
+		switch {
+		case st.Dirty:
+			return fmt.Errorf("migration %d_%s is dirty, fix the schema manually then run `demo migrate down`", st.Version, st.Name)
+		case st.Missing:
+			return fmt.Errorf("migration %d_%s is applied but unknown to this binary", st.Version, st.Name)
+		case st.ChecksumMismatch:
+			return fmt.Errorf("migration %d_%s was modified after being applied", st.Version, st.Name)
+		}
+	}
+	return nil
+}
+
+// Check 校验数据库结构是否为最新, 存在未执行的迁移时返回 ErrPendingMigrations
+func (m *Migrator) Check(ctx context.Context) error {
+	list, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+	if err := checkConsistent(list); err != nil {
+		return err
+	}
+	for _, st := range list {
+		if !st.Applied {
+			return ErrPendingMigrations
+		}
+	}
+	return nil
+}
+
+// Up 按顺序执行未执行的迁移, n<=0 时执行全部
+func (m *Migrator) Up(ctx context.Context, n int) ([]*Migration, error) {
+	list, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkConsistent(list); err != nil {
+		return nil, err
+	}
+	applied := make(map[int]bool, len(list))
+	for _, st := range list {
+		if st.Applied {
+			applied[st.Version] = true
+		}
+	}
+	if len(applied) == 0 {
+		if err := m.upgradeLegacy(ctx); err != nil {
+			return nil, fmt.Errorf("upgrade legacy schema: %w", err)
+		}
+	}
+	var done []*Migration
+	for _, mig := range m.migrations {
+		if applied[mig.Version] {
+			continue
+		}
+		if n > 0 && len(done) >= n {
+			break
+		}
+		if err := m.run(ctx, mig, true); err != nil {
+			return done, fmt.Errorf("migration %d_%s up: %w", mig.Version, mig.Name, err)
+		}
+		done = append(done, mig)
+	}
+	return done, nil
+}
+
+// Down 按倒序回滚最近执行的 n 个迁移, dirty 的迁移也可以回滚
+func (m *Migrator) Down(ctx context.Context, n int) ([]*Migration, error) {
+	list, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byVersion := make(map[int]*Migration, len(m.migrations))
+	for _, mig := range m.migrations {
+		byVersion[mig.Version] = mig
+	}
+	var done []*Migration
+	for i := len(list) - 1; i >= 0 && len(done) < n; i-- {
+		st := list[i]
+		if !st.Applied {
+			continue
+		}
+		mig, ok := byVersion[st.Version]
+		if !ok {
+			return done, fmt.Errorf("migration %d_%s is applied but unknown to this binary", st.Version, st.Name)
+		}
+		if err := m.run(ctx, mig, false); err != nil {
+			return done, fmt.Errorf("migration %d_%s down: %w", mig.Version, mig.Name, err)
+		}
+		done = append(done, mig)
+	}
+	return done, nil
+}
+
+// 引入迁移前由 AutoMigrate 建的库中文章没有 slug 字段, 且部分字段类型与 0001_init 不同,
+// 先将其升级为 0001_init 的结构, 再从 0001 开始执行迁移. 已执行的迁移文件不再修改
+func (m *Migrator) upgradeLegacy(ctx context.Context) error {
+	db := m.db.WithContext(ctx)
+	if !db.Migrator().HasTable("articles") || db.Migrator().HasColumn("articles", "slug") {
+		return nil
+	}
+	fn := func(tx *gorm.DB) error {
+		for _, stmt := range splitStatements(m.legacy) {
+			if err := tx.Exec(stmt).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if m.transactional {
+		return db.Transaction(fn)
+	}
+	return fn(db)
+}
+
+// 执行单个迁移并更新迁移记录, 执行前先标记 dirty, 成功后清除
+func (m *Migrator) run(ctx context.Context, mig *Migration, up bool) error {
+	fn := func(tx *gorm.DB) error {
+		sql := mig.Down
+		if up {
+			sql = mig.Up
+			rec := &SchemaMigration{Version: mig.Version, Name: mig.Name, Checksum: mig.Checksum, Dirty: true}
+			if err := tx.Create(rec).Error; err != nil {
+				return err
+			}
+		} else if err := tx.Model(&SchemaMigration{}).Where("version = ?", mig.Version).Update("dirty", true).Error; err != nil {
+			return err
+		}
+		for _, stmt := range splitStatements(sql) {
+			if err := tx.Exec(stmt).Error; err != nil {
+				return err
+			}
+		}
+		if up {
+			return tx.Model(&SchemaMigration{}).Where("version = ?", mig.Version).Update("dirty", false).Error
+		}
+		return tx.Where("version = ?", mig.Version).Delete(&SchemaMigration{}).Error
+	}
+	db := m.db.WithContext(ctx)
+	if m.transactional {
+		return db.Transaction(fn)
+	}
+	return fn(db)
+}
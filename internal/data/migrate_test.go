@@ -0,0 +1,243 @@
+package data
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"strings"
+	"testing"
+
+	"demo/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestMigrator(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("file:migrate?mode=memory&cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := NewMigrator(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	total := len(m.migrations)
+	if applied, err := m.Up(ctx, 0); err != nil || len(applied) != total {
+		t.Fatalf("up: %d %v", len(applied), err)
+	}
+	if err := m.Check(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Down(ctx, total); err != nil {
+		t.Fatal(err)
+	}
+	if db.Migrator().HasTable("articles") {
+		t.Fatal("articles should be dropped")
+	}
+	if err := m.Check(ctx); err != ErrPendingMigrations {
+		t.Fatalf("expected pending, got %v", err)
+	}
+	if _, err := m.Up(ctx, 0); err != nil {
+		t.Fatal(err)
+	}
+	// 只修改了注释的文件, 执行过旧文件的库仍视为一致
+	db.Model(&SchemaMigration{}).Where("version = ?", 1).Update("checksum", previousChecksums["sqlite/1"][0])
+	if err := m.Check(ctx); err != nil {
+		t.Fatal(err)
+	}
+	db.Model(&SchemaMigration{}).Where("version = ?", 1).Update("checksum", "changed")
+	if err := m.Check(ctx); err == nil {
+		t.Fatal("expected checksum mismatch")
+	}
+}
+
+// 已发布的迁移文件的校验和, 已执行过的库会因校验和不一致拒绝启动, 修改结构只能新增迁移;
+// 只修改注释时需将旧校验和加入 previousChecksums
+var shippedMigrations = map[string]string{
+	"mysql/1":     "52581936d4345dd1423cc3fdd8ad90a1146e7556e63b01da718a948192f01654",
+	"mysql/2":     "329ae6d04f5c836dc487109632b78ec9bd24b1eca67cd5f0af8b222cf51e58c1",
+	"mysql/3":     "2a72f124482a52f91ca7f011697f9b95da64b2d65eee7f1463e8c98b81068b46",
+	"mysql/4":     "0ed0fa0e1b7c1db497e9342de40db91f229fddde30be125054cc7ee839514f18",
+	"mysql/5":     "055cd3897d3e55089b6dd25bc5b3ae8a65a335308731849e69d8dfa742b0b4fe",
+	"mysql/6":     "a4e26e78a582523abfdd0ef64e94d2bf9c1cc5fa82d8759c1b3cc539c1c0624e",
+	"mysql/7":     "cd9958e361d24d9560d6193b6acb574e9eaae6efc3af50184f8fc0cd7eda427e",
+	"mysql/8":     "8034708383ceb473461285ae581f8bcdbe4cbc4a47fe30b921ba98d6c7c9f01a",
+	"mysql/9":     "dda3f547d60009be11b02774eebb56bf0b9f348c68866bb1e529115b2ec417ee",
+	"mysql/10":    "972cddc706959cbd2420096d03b15c17480882e61db216826daf9540b59e46bb",
+	"mysql/11":    "cf270d86c60d10af70a700bb0c72eff05c578863cd91955cc6c8f413fd459bb1",
+	"mysql/12":    "95279f44abb79a3d6be9a3349b135a9050e24a9e4da4c57c2e911348be90e157",
+	"mysql/13":    "c1c3340623ba539a7d3992f3460c8b6ded7ca65cdadc303ae6e70e52e29f5890",
+	"postgres/1":  "39834656ae70a639e63ac5b3df09a0938244906b522bde074bdb3d8059b4d0f3",
+	"postgres/2":  "953506bfe6f3609bf146caa3c1b61cedd33c7fe300330c3ba9cf9005e92e21d2",
+	"postgres/3":  "5dbfb14c93718a64155b9b188afbbc5c5eddf37e8474c9807235940ddc66a44d",
+	"postgres/4":  "dd13ba91662f627cfa3768b2b3d8271e98042c5ec879cad4c338bcfe4830e563",
+	"postgres/5":  "4b474dfa252b0734c8e34c5af0bc1d8f26074b091507c47c29d0fb35df28e1ea",
+	"postgres/6":  "4c7d9c048599d12d9cf4856e71100469d458f988e0ca52f240d3f8fc1f66ed4f",
+	"postgres/7":  "d384ff1c9dbb0679bef39d5a9f1cfc6ee1a445f0ca34e498a738f7db273ff91e",
+	"postgres/8":  "f493ec4a26950c66fa0802e7108b6e6f5071092c68dc55de04c8f1bc86c485c0",
+	"postgres/9":  "f4b374d674d90d5debd5b5143fb9784103262c03f9521af43e34491acb3f0b9c",
+	"postgres/10": "b2f0112b55fabc25729d7e6937617d367e6eda2307767e244adcd89e36fd951c",
+	"postgres/11": "8a233d647b3befc391014b034691693f05c546c1210f6b833ef38ba07394dc6c",
+	"postgres/12": "a847d886d4159c1c641f8b6e26bd7ee6890f83bf8cacb6c5c0c5abf550939136",
+	"postgres/13": "aaeb37e20fb24709d2dbe6f73fd829733a0fa5842f536fe3a960e8451f10e60d",
+	"sqlite/1":    "d237a58e3d4fa73bb37aaf26373fc91f236c1f5ae370edc761b0e33a8c836140",
+	"sqlite/2":    "86ed8e225c483d93a0aa86139fedbb2db5c18b1922105559356ceb783bfd5671",
+	"sqlite/3":    "5921cd17c450f0f91d0e17d8cdf06f0e37623423418d38dab15c3960741f2fc2",
+	"sqlite/4":    "aac9cd9a408a6a6a1d522852d2185d5b8b5e14105c9083e7a4c217003f877bad",
+	"sqlite/5":    "cd2a86abe22765bad4be715063d19e3abc1ea660846f4c3f330fc85cbfdb5f4e",
+	"sqlite/6":    "f757cb5a8b7276dc2ca77be9a74359f90b72fa44b5c84dee89cef817ee6ad2dd",
+	"sqlite/7":    "b1b0eca3752cdde41bf6b7b08370ea2a1dcf53a7491336e503a7e56192e4b3b4",
+	"sqlite/8":    "89dc358d6cd605ec7e6c653645ba840ac78b98f35334a16dd043dd5e449572f1",
+	"sqlite/9":    "7414f3a778a943d847cf813384553e3f53c26c0f282b5f13de1039b7b12784d3",
+	"sqlite/10":   "90c0b1c4a3fdc3231768820ef83618a2a2ae0b39c59959ca4b41d7374f8d1620",
+	"sqlite/11":   "98074cc1d47226d153f57f0c2cbb4c85729ff1ad640ca8438fba345db1def685",
+	"sqlite/12":   "937fc4a6c4e0d8bcf192c21252c8b99d55fd46bdd55cecc92a5946be5da972df",
+	"sqlite/13":   "cfb19daf8ea956e5a7dbc5c332358d640e510ac3feed80e4ff2093fbc4519963",
+}
+
+func TestShippedMigrationsUnchanged(t *testing.T) {
+	for _, dialect := range []string{"mysql", "postgres", "sqlite"} {
+		migrations, err := loadMigrations(migrationFS, path.Join("migrations", dialect))
+		if err != nil {
+			t.Fatal(err)
+		}
+		byVersion := map[string]*Migration{}
+		for _, mig := range migrations {
+			byVersion[dialect+"/"+strconv.Itoa(mig.Version)] = mig
+		}
+		for key, sum := range shippedMigrations {
+			if !strings.HasPrefix(key, dialect+"/") {
+				continue
+			}
+			mig, ok := byVersion[key]
+			if ok {
+				mig.PreviousChecksums = previousChecksums[key]
+			}
+			if !ok || !mig.matches(sum) {
+				t.Errorf("shipped migration %s was removed or modified, add a new migration instead", key)
+			}
+		}
+	}
+}
+
+// 引入迁移前 AutoMigrate 使用的模型
+type baselineUser struct {
+	ID         int    `gorm:"primarykey;auto_increment"`
+	CreatedAt  int    `gorm:"type:int(11);not null;default:0"`
+	UpdatedAt  int    `gorm:"type:int(11);not null;default:0"`
+	DeletedAt  int    `gorm:"type:int(11);not null;default:0"`
+	Email      string `gorm:"type:varchar(64);not null"`
+	Username   string `gorm:"type:varchar(64);not null"`
+	Bio        string `gorm:"type:varchar(128);not null"`
+	Image      string `gorm:"type:varchar(128);not null"`
+	PasswdHash string `gorm:"type:varchar(255);not null"`
+}
+
+func (baselineUser) TableName() string { return "users" }
+
+type baselineFollow struct {
+	ID        int `gorm:"type:int(11);primarykey;auto_increment"`
+	CreatedAt int `gorm:"type:int(11);not null;default:0"`
+	UpdatedAt int `gorm:"type:int(11);not null;default:0"`
+	DeletedAt int `gorm:"type:int(11);not null;default:0"`
+	FollowID  int `gorm:"type:int(11);not null"`
+	UserID    int `gorm:"type:int(11);not null"`
+}
+
+func (baselineFollow) TableName() string { return "follows" }
+
+type baselineArticle struct {
+	ID             int    `gorm:"type:int(11);primarykey;auto_increment"`
+	CreatedAt      int    `gorm:"type:int(11);not null;default:0"`
+	UpdatedAt      int    `gorm:"type:int(11);not null;default:0"`
+	DeletedAt      int    `gorm:"type:int(11);not null;default:0"`
+	Title          string `gorm:"type:varchar(64);not null"`
+	Description    string `gorm:"type:varchar(255);not null"`
+	Body           string `gorm:"type:varchar(511);not null"`
+	FavoritesCount int    `gorm:"type:int(11);not null;default:0"`
+	UserID         string `gorm:"type:int(11);not null"`
+}
+
+func (baselineArticle) TableName() string { return "articles" }
+
+type baselineTag struct {
+	ID        int    `gorm:"type:int(11);primarykey;auto_increment"`
+	CreatedAt int    `gorm:"type:int(11);not null;default:0"`
+	UpdatedAt int    `gorm:"type:int(11);not null;default:0"`
+	DeletedAt int    `gorm:"type:int(11);not null;default:0"`
+	ArticleID int    `gorm:"type:int(11);not null"`
+	Tag       string `gorm:"type:varchar(64);not null"`
+}
+
+func (baselineTag) TableName() string { return "tags" }
+
+type baselineComment struct {
+	ID        int    `gorm:"type:int(11);primarykey;auto_increment"`
+	CreatedAt int    `gorm:"type:int(11);not null;default:0"`
+	UpdatedAt int    `gorm:"type:int(11);not null;default:0"`
+	DeletedAt int    `gorm:"type:int(11);not null;default:0"`
+	Body      string `gorm:"type:varchar(64);not null"`
+	ArticleID int    `gorm:"type:varchar(64);not null"`
+	UserID    int    `gorm:"type:int(11);not null"`
+}
+
+func (baselineComment) TableName() string { return "comments" }
+
+// 已有库从 AutoMigrate 生成的结构升级
+func TestMigrateFromBaseline(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("file:migrate_baseline?mode=memory&cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&baselineUser{}, &baselineFollow{}, &baselineArticle{}, &baselineTag{}, &baselineComment{}); err != nil {
+		t.Fatal(err)
+	}
+	u := &baselineUser{Email: "a@b.c", Username: "a", PasswdHash: "x"}
+	if err := db.Create(u).Error; err != nil {
+		t.Fatal(err)
+	}
+	// sqlite 下 int(11) 主键不会自增, 手动指定 ID
+	old := &baselineArticle{ID: 1, Title: "hello", Description: "d", Body: "b", UserID: "1"}
+	if err := db.Create(old).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Create(&baselineComment{ID: 1, Body: "c", ArticleID: old.ID, UserID: u.ID}).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := NewMigrator(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if _, err := m.Up(ctx, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Check(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	d := &Data{db: db}
+	ar := NewArticleRepo(d, log.DefaultLogger)
+	a, err := ar.GetBySlug(ctx, "article-id-1")
+	if err != nil || a.ID != old.ID || a.Author.UserID != u.ID {
+		t.Fatalf("expected backfilled slug, got %+v %v", a, err)
+	}
+	cs, err := NewCommentRepo(d, log.DefaultLogger).List(ctx, old.ID)
+	if err != nil || len(cs) != 1 {
+		t.Fatalf("expected comment of article, got %+v %v", cs, err)
+	}
+	if ok, err := NewFavoriteRepo(d, log.DefaultLogger).Favorite(ctx, u.ID, old.ID); err != nil || !ok {
+		t.Fatalf("expected favorite, got %v %v", ok, err)
+	}
+	// slug 唯一
+	if _, err := ar.Create(ctx, &biz.Article{Title: "hello", Slug: "article-id-1", Author: biz.Author{UserID: u.ID}}); err == nil {
+		t.Fatal("expected duplicate slug to be rejected")
+	}
+	if _, err := m.Down(ctx, len(m.migrations)); err != nil {
+		t.Fatal(err)
+	}
+}
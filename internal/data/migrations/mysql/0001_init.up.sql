@@ -0,0 +1,82 @@
+-- 第一个版本化的表结构; 引入迁移前由 AutoMigrate 建的库结构不同, 由 upgradeLegacy 先执行 legacy/upgrade.sql 升级到该结构
+CREATE TABLE IF NOT EXISTS `users` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `created_at` bigint NOT NULL DEFAULT 0 COMMENT '创建时间',
+  `updated_at` bigint NOT NULL DEFAULT 0 COMMENT '更新时间',
+  `deleted_at` bigint NOT NULL DEFAULT 0 COMMENT '删除时间',
+  `email` varchar(64) NOT NULL COMMENT '邮箱',
+  `username` varchar(64) NOT NULL COMMENT '用户名',
+  `bio` varchar(128) NOT NULL COMMENT '简介',
+  `image` varchar(128) NOT NULL COMMENT '图片',
+  `passwd_hash` varchar(255) NOT NULL COMMENT '密码',
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `follows` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `created_at` bigint NOT NULL DEFAULT 0 COMMENT '创建时间',
+  `updated_at` bigint NOT NULL DEFAULT 0 COMMENT '更新时间',
+  `deleted_at` bigint NOT NULL DEFAULT 0 COMMENT '删除时间',
+  `follow_id` bigint NOT NULL COMMENT '关注人ID',
+  `user_id` bigint NOT NULL COMMENT '用户ID',
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `articles` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `created_at` bigint NOT NULL DEFAULT 0 COMMENT '创建时间',
+  `updated_at` bigint NOT NULL DEFAULT 0 COMMENT '更新时间',
+  `deleted_at` bigint NOT NULL DEFAULT 0 COMMENT '删除时间',
+  `title` varchar(64) NOT NULL COMMENT '文章标题',
+  `slug` varchar(128) NOT NULL DEFAULT '' COMMENT '文章slug',
+  `description` varchar(255) NOT NULL COMMENT '文章描述',
+  `body` varchar(511) NOT NULL COMMENT '文章体',
+  `favorites_count` bigint NOT NULL DEFAULT 0 COMMENT '赞数量',
+  `user_id` bigint NOT NULL DEFAULT 0 COMMENT '用户ID',
+  PRIMARY KEY (`id`),
+  KEY `idx_articles_slug` (`slug`),
+  KEY `idx_articles_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `tags` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `created_at` bigint NOT NULL DEFAULT 0 COMMENT '创建时间',
+  `updated_at` bigint NOT NULL DEFAULT 0 COMMENT '更新时间',
+  `deleted_at` bigint NOT NULL DEFAULT 0 COMMENT '删除时间',
+  `article_id` bigint NOT NULL COMMENT '文章ID',
+  `tag` varchar(64) NOT NULL COMMENT '标记',
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `comments` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `created_at` bigint NOT NULL DEFAULT 0 COMMENT '创建时间',
+  `updated_at` bigint NOT NULL DEFAULT 0 COMMENT '更新时间',
+  `deleted_at` bigint NOT NULL DEFAULT 0 COMMENT '删除时间',
+  `body` varchar(64) NOT NULL COMMENT '评论内容',
+  `article_id` bigint NOT NULL COMMENT '文章ID',
+  `user_id` bigint NOT NULL DEFAULT 0 COMMENT '用户ID',
+  PRIMARY KEY (`id`),
+  KEY `idx_comments_article_id` (`article_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `favorites` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `created_at` bigint NOT NULL DEFAULT 0 COMMENT '创建时间',
+  `updated_at` bigint NOT NULL DEFAULT 0 COMMENT '更新时间',
+  `user_id` bigint NOT NULL COMMENT '用户ID',
+  `article_id` bigint NOT NULL COMMENT '文章ID',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_user_article` (`user_id`, `article_id`),
+  KEY `idx_favorites_article_id` (`article_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `article_slugs` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `created_at` bigint NOT NULL DEFAULT 0 COMMENT '创建时间',
+  `slug` varchar(128) NOT NULL COMMENT '旧slug',
+  `article_id` bigint NOT NULL COMMENT '文章ID',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_article_slugs_slug` (`slug`),
+  KEY `idx_article_slugs_article_id` (`article_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
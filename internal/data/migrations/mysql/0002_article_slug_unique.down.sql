@@ -0,0 +1,2 @@
+DROP INDEX `idx_articles_slug` ON `articles`;
+CREATE INDEX `idx_articles_slug` ON `articles`(`slug`);
@@ -0,0 +1,24 @@
+-- refresh token 与 access token 吊销
+ALTER TABLE `users` ADD COLUMN `token_version` bigint NOT NULL DEFAULT 0 COMMENT 'token版本, 递增后旧 token 失效';
+
+CREATE TABLE IF NOT EXISTS `refresh_tokens` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `created_at` bigint NOT NULL DEFAULT 0 COMMENT '创建时间',
+  `user_id` bigint NOT NULL COMMENT '用户ID',
+  `token_hash` varchar(64) NOT NULL COMMENT 'token sha256',
+  `expires_at` bigint NOT NULL COMMENT '过期时间',
+  `revoked_at` bigint NOT NULL DEFAULT 0 COMMENT '吊销时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_refresh_tokens_token_hash` (`token_hash`),
+  KEY `idx_refresh_tokens_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `revoked_tokens` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `created_at` bigint NOT NULL DEFAULT 0 COMMENT '创建时间',
+  `jti` varchar(64) NOT NULL COMMENT 'token ID',
+  `expires_at` bigint NOT NULL COMMENT 'token 过期时间, 过期后可清理',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_revoked_tokens_jti` (`jti`),
+  KEY `idx_revoked_tokens_expires_at` (`expires_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
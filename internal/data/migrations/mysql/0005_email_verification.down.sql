@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS `action_tokens`;
+ALTER TABLE `users` DROP COLUMN `email_verified_at`;
@@ -0,0 +1,15 @@
+-- 邮箱验证与重置密码
+ALTER TABLE `users` ADD COLUMN `email_verified_at` bigint NOT NULL DEFAULT 0 COMMENT '邮箱验证时间';
+
+CREATE TABLE IF NOT EXISTS `action_tokens` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `created_at` bigint NOT NULL DEFAULT 0 COMMENT '创建时间',
+  `jti` varchar(64) NOT NULL COMMENT 'token ID',
+  `user_id` bigint NOT NULL COMMENT '用户ID',
+  `purpose` varchar(32) NOT NULL COMMENT '用途',
+  `expires_at` bigint NOT NULL COMMENT '过期时间',
+  `used_at` bigint NOT NULL DEFAULT 0 COMMENT '使用时间, 失效的 token 同样记录',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_action_tokens_jti` (`jti`),
+  KEY `idx_action_tokens_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS `recovery_codes`;
+ALTER TABLE `users` DROP COLUMN `totp_enabled_at`;
+ALTER TABLE `users` DROP COLUMN `totp_secret`;
@@ -0,0 +1,13 @@
+-- TOTP 两步验证
+ALTER TABLE `users` ADD COLUMN `totp_secret` varchar(64) NOT NULL DEFAULT '' COMMENT 'TOTP密钥';
+ALTER TABLE `users` ADD COLUMN `totp_enabled_at` bigint NOT NULL DEFAULT 0 COMMENT 'TOTP启用时间';
+
+CREATE TABLE IF NOT EXISTS `recovery_codes` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `created_at` bigint NOT NULL DEFAULT 0 COMMENT '创建时间',
+  `user_id` bigint NOT NULL COMMENT '用户ID',
+  `code_hash` varchar(64) NOT NULL COMMENT '恢复码 sha256',
+  `used_at` bigint NOT NULL DEFAULT 0 COMMENT '使用时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_recovery_codes_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
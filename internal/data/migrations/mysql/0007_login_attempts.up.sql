@@ -0,0 +1,11 @@
+-- 登录失败计数, 多实例部署时使用 login_throttle.store: database
+CREATE TABLE IF NOT EXISTS `login_attempts` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `updated_at` bigint NOT NULL DEFAULT 0 COMMENT '更新时间',
+  `attempt_key` varchar(191) NOT NULL COMMENT 'account:邮箱 或 ip:地址',
+  `failures` bigint NOT NULL DEFAULT 0 COMMENT '失败次数',
+  `last_failed_at` bigint NOT NULL DEFAULT 0 COMMENT '最近失败时间',
+  `locked_until` bigint NOT NULL DEFAULT 0 COMMENT '锁定截止时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_login_attempts_attempt_key` (`attempt_key`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,25 @@
+-- OpenID Connect 登录
+CREATE TABLE IF NOT EXISTS `linked_identities` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `created_at` bigint NOT NULL DEFAULT 0 COMMENT '创建时间',
+  `user_id` bigint NOT NULL COMMENT '用户ID',
+  `provider` varchar(32) NOT NULL COMMENT '身份提供方',
+  `subject` varchar(191) NOT NULL COMMENT '提供方的用户ID(sub)',
+  `email` varchar(191) NOT NULL DEFAULT '' COMMENT '提供方返回的邮箱',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_linked_identities_provider_subject` (`provider`, `subject`),
+  UNIQUE KEY `idx_linked_identities_user_provider` (`user_id`, `provider`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `oidc_states` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `created_at` bigint NOT NULL DEFAULT 0 COMMENT '创建时间',
+  `state` varchar(64) NOT NULL COMMENT 'state 参数',
+  `provider` varchar(32) NOT NULL COMMENT '身份提供方',
+  `verifier` varchar(128) NOT NULL COMMENT 'PKCE code_verifier',
+  `nonce` varchar(64) NOT NULL COMMENT 'ID token nonce',
+  `user_id` bigint NOT NULL DEFAULT 0 COMMENT '绑定账号时的当前用户',
+  `expires_at` bigint NOT NULL COMMENT '过期时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_oidc_states_state` (`state`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
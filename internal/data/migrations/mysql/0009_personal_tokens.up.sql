@@ -0,0 +1,15 @@
+-- 个人访问令牌
+CREATE TABLE IF NOT EXISTS `personal_tokens` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `created_at` bigint NOT NULL DEFAULT 0 COMMENT '创建时间',
+  `user_id` bigint NOT NULL COMMENT '用户ID',
+  `name` varchar(64) NOT NULL COMMENT '名称',
+  `token_hash` varchar(64) NOT NULL COMMENT '令牌 sha256',
+  `prefix` varchar(16) NOT NULL COMMENT '令牌前几位',
+  `scopes` varchar(255) NOT NULL COMMENT '授权范围, 空格分隔',
+  `expires_at` bigint NOT NULL DEFAULT 0 COMMENT '过期时间, 0 为永不过期',
+  `last_used_at` bigint NOT NULL DEFAULT 0 COMMENT '最近使用时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_personal_tokens_token_hash` (`token_hash`),
+  UNIQUE KEY `idx_personal_tokens_user_name` (`user_id`, `name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,16 @@
+-- 登录会话
+CREATE TABLE IF NOT EXISTS `sessions` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `created_at` bigint NOT NULL DEFAULT 0 COMMENT '创建时间',
+  `user_id` bigint NOT NULL COMMENT '用户ID',
+  `user_agent` varchar(255) NOT NULL DEFAULT '' COMMENT 'User-Agent',
+  `device` varchar(64) NOT NULL DEFAULT '' COMMENT '设备描述',
+  `ip` varchar(64) NOT NULL DEFAULT '' COMMENT 'IP',
+  `last_seen_at` bigint NOT NULL DEFAULT 0 COMMENT '最近活跃时间',
+  `expires_at` bigint NOT NULL COMMENT '过期时间',
+  `revoked_at` bigint NOT NULL DEFAULT 0 COMMENT '退出时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_sessions_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+ALTER TABLE `refresh_tokens` ADD COLUMN `session_id` bigint NOT NULL DEFAULT 0 COMMENT '会话ID';
@@ -0,0 +1,2 @@
+-- TOTP 防重放
+ALTER TABLE `users` ADD COLUMN `totp_last_step` bigint NOT NULL DEFAULT 0 COMMENT 'TOTP最近使用的时间窗口';
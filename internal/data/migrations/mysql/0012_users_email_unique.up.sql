@@ -0,0 +1,2 @@
+-- 邮箱唯一, 已删除用户的邮箱可以重新注册; 已有重复邮箱时需先手动处理
+CREATE UNIQUE INDEX `idx_users_email` ON `users`(`email`, `deleted_at`);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS `login_attempt_ips`;
+ALTER TABLE `login_attempts` DROP COLUMN `lockouts`;
@@ -0,0 +1,10 @@
+-- 登录锁定等级与账号失败来源 IP
+ALTER TABLE `login_attempts` ADD COLUMN `lockouts` bigint NOT NULL DEFAULT 0 COMMENT '累计锁定次数';
+CREATE TABLE IF NOT EXISTS `login_attempt_ips` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `attempt_key` varchar(191) NOT NULL COMMENT 'account:邮箱',
+  `ip` varchar(64) NOT NULL COMMENT '来源IP',
+  `last_failed_at` bigint NOT NULL DEFAULT 0 COMMENT '最近失败时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_login_attempt_ips_key_ip` (`attempt_key`, `ip`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,8 @@
+-- 将引入迁移前 AutoMigrate 生成的表结构升级为 0001_init 的结构, 之后 0001/0002 会补齐新表并回填 slug
+ALTER TABLE `articles` ADD COLUMN `slug` varchar(128) NOT NULL DEFAULT '' COMMENT '文章slug' AFTER `title`;
+CREATE INDEX `idx_articles_slug` ON `articles`(`slug`);
+ALTER TABLE `articles` MODIFY `user_id` bigint NOT NULL DEFAULT 0 COMMENT '用户ID';
+CREATE INDEX `idx_articles_user_id` ON `articles`(`user_id`);
+ALTER TABLE `comments` MODIFY `article_id` bigint NOT NULL COMMENT '文章ID';
+ALTER TABLE `comments` MODIFY `user_id` bigint NOT NULL DEFAULT 0 COMMENT '用户ID';
+CREATE INDEX `idx_comments_article_id` ON `comments`(`article_id`);
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS "article_slugs";
+DROP TABLE IF EXISTS "favorites";
+DROP TABLE IF EXISTS "comments";
+DROP TABLE IF EXISTS "tags";
+DROP TABLE IF EXISTS "articles";
+DROP TABLE IF EXISTS "follows";
+DROP TABLE IF EXISTS "users";
@@ -0,0 +1,22 @@
+-- refresh token 与 access token 吊销
+ALTER TABLE "users" ADD COLUMN "token_version" bigint NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS "refresh_tokens" (
+  "id" bigserial PRIMARY KEY,
+  "created_at" bigint NOT NULL DEFAULT 0,
+  "user_id" bigint NOT NULL,
+  "token_hash" varchar(64) NOT NULL,
+  "expires_at" bigint NOT NULL,
+  "revoked_at" bigint NOT NULL DEFAULT 0
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_refresh_tokens_token_hash" ON "refresh_tokens"("token_hash");
+CREATE INDEX IF NOT EXISTS "idx_refresh_tokens_user_id" ON "refresh_tokens"("user_id");
+
+CREATE TABLE IF NOT EXISTS "revoked_tokens" (
+  "id" bigserial PRIMARY KEY,
+  "created_at" bigint NOT NULL DEFAULT 0,
+  "jti" varchar(64) NOT NULL,
+  "expires_at" bigint NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_revoked_tokens_jti" ON "revoked_tokens"("jti");
+CREATE INDEX IF NOT EXISTS "idx_revoked_tokens_expires_at" ON "revoked_tokens"("expires_at");
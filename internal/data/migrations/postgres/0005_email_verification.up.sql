@@ -0,0 +1,14 @@
+-- 邮箱验证与重置密码
+ALTER TABLE "users" ADD COLUMN "email_verified_at" bigint NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS "action_tokens" (
+  "id" bigserial PRIMARY KEY,
+  "created_at" bigint NOT NULL DEFAULT 0,
+  "jti" varchar(64) NOT NULL,
+  "user_id" bigint NOT NULL,
+  "purpose" varchar(32) NOT NULL,
+  "expires_at" bigint NOT NULL,
+  "used_at" bigint NOT NULL DEFAULT 0
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_action_tokens_jti" ON "action_tokens"("jti");
+CREATE INDEX IF NOT EXISTS "idx_action_tokens_user_id" ON "action_tokens"("user_id");
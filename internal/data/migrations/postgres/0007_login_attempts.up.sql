@@ -0,0 +1,10 @@
+-- 登录失败计数, 多实例部署时使用 login_throttle.store: database
+CREATE TABLE IF NOT EXISTS "login_attempts" (
+  "id" bigserial PRIMARY KEY,
+  "updated_at" bigint NOT NULL DEFAULT 0,
+  "attempt_key" varchar(191) NOT NULL,
+  "failures" bigint NOT NULL DEFAULT 0,
+  "last_failed_at" bigint NOT NULL DEFAULT 0,
+  "locked_until" bigint NOT NULL DEFAULT 0
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_login_attempts_attempt_key" ON "login_attempts"("attempt_key");
@@ -0,0 +1,23 @@
+-- OpenID Connect 登录
+CREATE TABLE IF NOT EXISTS "linked_identities" (
+  "id" bigserial PRIMARY KEY,
+  "created_at" bigint NOT NULL DEFAULT 0,
+  "user_id" bigint NOT NULL,
+  "provider" varchar(32) NOT NULL,
+  "subject" varchar(191) NOT NULL,
+  "email" varchar(191) NOT NULL DEFAULT ''
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_linked_identities_provider_subject" ON "linked_identities"("provider", "subject");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_linked_identities_user_provider" ON "linked_identities"("user_id", "provider");
+
+CREATE TABLE IF NOT EXISTS "oidc_states" (
+  "id" bigserial PRIMARY KEY,
+  "created_at" bigint NOT NULL DEFAULT 0,
+  "state" varchar(64) NOT NULL,
+  "provider" varchar(32) NOT NULL,
+  "verifier" varchar(128) NOT NULL,
+  "nonce" varchar(64) NOT NULL,
+  "user_id" bigint NOT NULL DEFAULT 0,
+  "expires_at" bigint NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_oidc_states_state" ON "oidc_states"("state");
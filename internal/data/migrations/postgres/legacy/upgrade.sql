@@ -0,0 +1,5 @@
+-- 将引入迁移前 AutoMigrate 生成的表结构升级为 0001_init 的结构, 之后 0001/0002 会补齐索引、新表并回填 slug
+ALTER TABLE "articles" ADD COLUMN "slug" varchar(128) NOT NULL DEFAULT '';
+ALTER TABLE "articles" ALTER COLUMN "user_id" TYPE bigint USING "user_id"::bigint, ALTER COLUMN "user_id" SET DEFAULT 0;
+ALTER TABLE "comments" ALTER COLUMN "article_id" TYPE bigint USING "article_id"::bigint;
+ALTER TABLE "comments" ALTER COLUMN "user_id" SET DEFAULT 0;
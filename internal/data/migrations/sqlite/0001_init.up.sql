@@ -0,0 +1,75 @@
+-- 第一个版本化的表结构; 引入迁移前由 AutoMigrate 建的库结构不同, 由 upgradeLegacy 先执行 legacy/upgrade.sql 升级到该结构
+CREATE TABLE IF NOT EXISTS `users` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` integer NOT NULL DEFAULT 0,
+  `updated_at` integer NOT NULL DEFAULT 0,
+  `deleted_at` integer NOT NULL DEFAULT 0,
+  `email` varchar(64) NOT NULL,
+  `username` varchar(64) NOT NULL,
+  `bio` varchar(128) NOT NULL,
+  `image` varchar(128) NOT NULL,
+  `passwd_hash` varchar(255) NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS `follows` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` integer NOT NULL DEFAULT 0,
+  `updated_at` integer NOT NULL DEFAULT 0,
+  `deleted_at` integer NOT NULL DEFAULT 0,
+  `follow_id` integer NOT NULL,
+  `user_id` integer NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS `articles` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` integer NOT NULL DEFAULT 0,
+  `updated_at` integer NOT NULL DEFAULT 0,
+  `deleted_at` integer NOT NULL DEFAULT 0,
+  `title` varchar(64) NOT NULL,
+  `slug` varchar(128) NOT NULL DEFAULT '',
+  `description` varchar(255) NOT NULL,
+  `body` varchar(511) NOT NULL,
+  `favorites_count` integer NOT NULL DEFAULT 0,
+  `user_id` integer NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS `idx_articles_slug` ON `articles`(`slug`);
+CREATE INDEX IF NOT EXISTS `idx_articles_user_id` ON `articles`(`user_id`);
+
+CREATE TABLE IF NOT EXISTS `tags` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` integer NOT NULL DEFAULT 0,
+  `updated_at` integer NOT NULL DEFAULT 0,
+  `deleted_at` integer NOT NULL DEFAULT 0,
+  `article_id` integer NOT NULL,
+  `tag` varchar(64) NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS `comments` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` integer NOT NULL DEFAULT 0,
+  `updated_at` integer NOT NULL DEFAULT 0,
+  `deleted_at` integer NOT NULL DEFAULT 0,
+  `body` varchar(64) NOT NULL,
+  `article_id` integer NOT NULL,
+  `user_id` integer NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS `idx_comments_article_id` ON `comments`(`article_id`);
+
+CREATE TABLE IF NOT EXISTS `favorites` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` integer NOT NULL DEFAULT 0,
+  `updated_at` integer NOT NULL DEFAULT 0,
+  `user_id` integer NOT NULL,
+  `article_id` integer NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_user_article` ON `favorites`(`user_id`, `article_id`);
+CREATE INDEX IF NOT EXISTS `idx_favorites_article_id` ON `favorites`(`article_id`);
+
+CREATE TABLE IF NOT EXISTS `article_slugs` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` integer NOT NULL DEFAULT 0,
+  `slug` varchar(128) NOT NULL,
+  `article_id` integer NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_article_slugs_slug` ON `article_slugs`(`slug`);
+CREATE INDEX IF NOT EXISTS `idx_article_slugs_article_id` ON `article_slugs`(`article_id`);
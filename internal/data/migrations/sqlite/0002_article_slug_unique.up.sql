@@ -0,0 +1,4 @@
+-- 为没有 slug 的历史文章回填 slug, 并将 slug 改为唯一索引
+UPDATE `articles` SET `slug` = 'article-id-' || `id` WHERE `slug` = '';
+DROP INDEX IF EXISTS `idx_articles_slug`;
+CREATE UNIQUE INDEX `idx_articles_slug` ON `articles`(`slug`);
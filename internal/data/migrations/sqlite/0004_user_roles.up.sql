@@ -0,0 +1,3 @@
+-- 用户角色: user, moderator, admin
+ALTER TABLE `users` ADD COLUMN `role` varchar(16) NOT NULL DEFAULT 'user';
+CREATE INDEX IF NOT EXISTS `idx_users_role` ON `users`(`role`);
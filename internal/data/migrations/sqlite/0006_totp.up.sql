@@ -0,0 +1,12 @@
+-- TOTP 两步验证
+ALTER TABLE `users` ADD COLUMN `totp_secret` varchar(64) NOT NULL DEFAULT '';
+ALTER TABLE `users` ADD COLUMN `totp_enabled_at` integer NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS `recovery_codes` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` integer NOT NULL DEFAULT 0,
+  `user_id` integer NOT NULL,
+  `code_hash` varchar(64) NOT NULL,
+  `used_at` integer NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS `idx_recovery_codes_user_id` ON `recovery_codes`(`user_id`);
@@ -0,0 +1,10 @@
+-- 登录失败计数, 多实例部署时使用 login_throttle.store: database
+CREATE TABLE IF NOT EXISTS `login_attempts` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `updated_at` integer NOT NULL DEFAULT 0,
+  `attempt_key` varchar(191) NOT NULL,
+  `failures` integer NOT NULL DEFAULT 0,
+  `last_failed_at` integer NOT NULL DEFAULT 0,
+  `locked_until` integer NOT NULL DEFAULT 0
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_login_attempts_attempt_key` ON `login_attempts`(`attempt_key`);
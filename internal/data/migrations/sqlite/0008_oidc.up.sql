@@ -0,0 +1,23 @@
+-- OpenID Connect 登录
+CREATE TABLE IF NOT EXISTS `linked_identities` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` integer NOT NULL DEFAULT 0,
+  `user_id` integer NOT NULL,
+  `provider` varchar(32) NOT NULL,
+  `subject` varchar(191) NOT NULL,
+  `email` varchar(191) NOT NULL DEFAULT ''
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_linked_identities_provider_subject` ON `linked_identities`(`provider`, `subject`);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_linked_identities_user_provider` ON `linked_identities`(`user_id`, `provider`);
+
+CREATE TABLE IF NOT EXISTS `oidc_states` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` integer NOT NULL DEFAULT 0,
+  `state` varchar(64) NOT NULL,
+  `provider` varchar(32) NOT NULL,
+  `verifier` varchar(128) NOT NULL,
+  `nonce` varchar(64) NOT NULL,
+  `user_id` integer NOT NULL DEFAULT 0,
+  `expires_at` integer NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_oidc_states_state` ON `oidc_states`(`state`);
@@ -0,0 +1,14 @@
+-- 个人访问令牌
+CREATE TABLE IF NOT EXISTS `personal_tokens` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` integer NOT NULL DEFAULT 0,
+  `user_id` integer NOT NULL,
+  `name` varchar(64) NOT NULL,
+  `token_hash` varchar(64) NOT NULL,
+  `prefix` varchar(16) NOT NULL,
+  `scopes` varchar(255) NOT NULL,
+  `expires_at` integer NOT NULL DEFAULT 0,
+  `last_used_at` integer NOT NULL DEFAULT 0
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_personal_tokens_token_hash` ON `personal_tokens`(`token_hash`);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_personal_tokens_user_name` ON `personal_tokens`(`user_id`, `name`);
@@ -0,0 +1,2 @@
+ALTER TABLE `refresh_tokens` DROP COLUMN `session_id`;
+DROP TABLE IF EXISTS `sessions`;
@@ -0,0 +1,15 @@
+-- 登录会话
+CREATE TABLE IF NOT EXISTS `sessions` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` integer NOT NULL DEFAULT 0,
+  `user_id` integer NOT NULL,
+  `user_agent` varchar(255) NOT NULL DEFAULT '',
+  `device` varchar(64) NOT NULL DEFAULT '',
+  `ip` varchar(64) NOT NULL DEFAULT '',
+  `last_seen_at` integer NOT NULL DEFAULT 0,
+  `expires_at` integer NOT NULL,
+  `revoked_at` integer NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS `idx_sessions_user_id` ON `sessions`(`user_id`);
+
+ALTER TABLE `refresh_tokens` ADD COLUMN `session_id` integer NOT NULL DEFAULT 0;
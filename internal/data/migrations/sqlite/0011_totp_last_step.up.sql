@@ -0,0 +1,2 @@
+-- TOTP 防重放
+ALTER TABLE `users` ADD COLUMN `totp_last_step` integer NOT NULL DEFAULT 0;
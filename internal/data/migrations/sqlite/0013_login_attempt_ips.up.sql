@@ -0,0 +1,9 @@
+-- 登录锁定等级与账号失败来源 IP
+ALTER TABLE `login_attempts` ADD COLUMN `lockouts` integer NOT NULL DEFAULT 0;
+CREATE TABLE IF NOT EXISTS `login_attempt_ips` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `attempt_key` varchar(191) NOT NULL,
+  `ip` varchar(64) NOT NULL,
+  `last_failed_at` integer NOT NULL DEFAULT 0
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_login_attempt_ips_key_ip` ON `login_attempt_ips`(`attempt_key`, `ip`);
@@ -0,0 +1,32 @@
+-- 将引入迁移前 AutoMigrate 生成的表结构升级为 0001_init 的结构, 之后 0001/0002 会补齐索引、新表并回填 slug
+-- sqlite 不支持修改字段类型, 通过重建表完成
+CREATE TABLE `articles_v1` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` integer NOT NULL DEFAULT 0,
+  `updated_at` integer NOT NULL DEFAULT 0,
+  `deleted_at` integer NOT NULL DEFAULT 0,
+  `title` varchar(64) NOT NULL,
+  `slug` varchar(128) NOT NULL DEFAULT '',
+  `description` varchar(255) NOT NULL,
+  `body` varchar(511) NOT NULL,
+  `favorites_count` integer NOT NULL DEFAULT 0,
+  `user_id` integer NOT NULL DEFAULT 0
+);
+INSERT INTO `articles_v1` (`id`, `created_at`, `updated_at`, `deleted_at`, `title`, `description`, `body`, `favorites_count`, `user_id`)
+  SELECT `id`, `created_at`, `updated_at`, `deleted_at`, `title`, `description`, `body`, `favorites_count`, CAST(`user_id` AS integer) FROM `articles`;
+DROP TABLE `articles`;
+ALTER TABLE `articles_v1` RENAME TO `articles`;
+
+CREATE TABLE `comments_v1` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` integer NOT NULL DEFAULT 0,
+  `updated_at` integer NOT NULL DEFAULT 0,
+  `deleted_at` integer NOT NULL DEFAULT 0,
+  `body` varchar(64) NOT NULL,
+  `article_id` integer NOT NULL,
+  `user_id` integer NOT NULL DEFAULT 0
+);
+INSERT INTO `comments_v1` (`id`, `created_at`, `updated_at`, `deleted_at`, `body`, `article_id`, `user_id`)
+  SELECT `id`, `created_at`, `updated_at`, `deleted_at`, `body`, CAST(`article_id` AS integer), `user_id` FROM `comments`;
+DROP TABLE `comments`;
+ALTER TABLE `comments_v1` RENAME TO `comments`;
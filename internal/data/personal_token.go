@@ -0,0 +1,114 @@
+package data
+
+import (
+	"context"
+	"demo/internal/biz"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// 个人访问令牌, 只保存哈希
+type PersonalToken struct {
+	ID         int    `gorm:"primaryKey;autoIncrement"`
+	CreatedAt  int    `gorm:"not null;comment:创建时间" json:"created_at"`
+	UserID     int    `gorm:"not null;uniqueIndex:idx_personal_tokens_user_name;comment:用户ID" json:"user_id"`
+	Name       string `gorm:"type:varchar(64);not null;uniqueIndex:idx_personal_tokens_user_name;comment:名称" json:"name"`
+	TokenHash  string `gorm:"type:varchar(64);not null;uniqueIndex;comment:令牌 sha256" json:"-"`
+	Prefix     string `gorm:"type:varchar(16);not null;comment:令牌前几位" json:"prefix"`
+	Scopes     string `gorm:"type:varchar(255);not null;comment:授权范围, 空格分隔" json:"scopes"`
+	ExpiresAt  int64  `gorm:"not null;default:0;comment:过期时间, 0 为永不过期" json:"expires_at"`
+	LastUsedAt int64  `gorm:"not null;default:0;comment:最近使用时间" json:"last_used_at"`
+}
+
+type personalTokenRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewPersonalTokenRepo(data *Data, logger log.Logger) biz.PersonalTokenRepo {
+	return &personalTokenRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func unixOrZero(t int64) time.Time {
+	if t == 0 {
+		return time.Time{}
+	}
+	return time.Unix(t, 0)
+}
+
+func toPersonalToken(po *PersonalToken) *biz.PersonalToken {
+	return &biz.PersonalToken{
+		ID:         po.ID,
+		UserID:     po.UserID,
+		Name:       po.Name,
+		TokenHash:  po.TokenHash,
+		Prefix:     po.Prefix,
+		Scopes:     strings.Fields(po.Scopes),
+		CreatedAt:  time.Unix(int64(po.CreatedAt), 0),
+		ExpiresAt:  unixOrZero(po.ExpiresAt),
+		LastUsedAt: unixOrZero(po.LastUsedAt),
+	}
+}
+
+func (r *personalTokenRepo) CreatePersonalToken(ctx context.Context, t *biz.PersonalToken) error {
+	po := &PersonalToken{
+		UserID:    t.UserID,
+		Name:      t.Name,
+		TokenHash: t.TokenHash,
+		Prefix:    t.Prefix,
+		Scopes:    strings.Join(t.Scopes, " "),
+	}
+	if !t.ExpiresAt.IsZero() {
+		po.ExpiresAt = t.ExpiresAt.Unix()
+	}
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		return err
+	}
+	t.ID = po.ID
+	t.CreatedAt = time.Unix(int64(po.CreatedAt), 0)
+	return nil
+}
+
+func (r *personalTokenRepo) ListPersonalTokens(ctx context.Context, userId int) ([]*biz.PersonalToken, error) {
+	var pos []*PersonalToken
+	if err := r.data.DB(ctx).Where("user_id=?", userId).Order("id").Find(&pos).Error; err != nil {
+		return nil, err
+	}
+	rv := make([]*biz.PersonalToken, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toPersonalToken(po))
+	}
+	return rv, nil
+}
+
+func (r *personalTokenRepo) GetPersonalTokenByHash(ctx context.Context, hash string) (*biz.PersonalToken, error) {
+	po := new(PersonalToken)
+	rv := r.data.DB(ctx).Where("token_hash=?", hash).First(po)
+	if errors.Is(rv.Error, gorm.ErrRecordNotFound) {
+		return nil, errors.NotFound("token", "not found")
+	}
+	if rv.Error != nil {
+		return nil, rv.Error
+	}
+	return toPersonalToken(po), nil
+}
+
+func (r *personalTokenRepo) DeletePersonalToken(ctx context.Context, userId, id int) (bool, error) {
+	rv := r.data.DB(ctx).Where("id=? and user_id=?", id, userId).Delete(&PersonalToken{})
+	return rv.RowsAffected > 0, rv.Error
+}
+
+func (r *personalTokenRepo) DeleteUserPersonalTokens(ctx context.Context, userId int) error {
+	return r.data.DB(ctx).Where("user_id=?", userId).Delete(&PersonalToken{}).Error
+}
+
+func (r *personalTokenRepo) TouchPersonalToken(ctx context.Context, id int, t time.Time) error {
+	return r.data.DB(ctx).Model(&PersonalToken{}).Where("id=?", id).Update("last_used_at", t.Unix()).Error
+}
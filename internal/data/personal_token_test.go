@@ -0,0 +1,58 @@
+package data
+
+import (
+	"context"
+	"demo/internal/biz"
+	"demo/internal/conf"
+	"demo/internal/pkg/middleware/auth"
+	"testing"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestPersonalTokenRepo(t *testing.T) {
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Dsn: "file:" + t.Name() + "?mode=memory&cache=shared", AutoMigrate: true}}
+	db, err := NewDB(c, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &Data{db: db}
+	ptr := NewPersonalTokenRepo(d, log.DefaultLogger)
+	ctx := context.Background()
+	expiresAt := time.Now().Add(time.Hour)
+	pt := &biz.PersonalToken{UserID: 1, Name: "ci", TokenHash: "hash", Prefix: "rwp_", Scopes: []string{auth.ScopeArticlesWrite, auth.ScopeProfileRead}, ExpiresAt: expiresAt}
+	if err := ptr.CreatePersonalToken(ctx, pt); err != nil {
+		t.Fatal(err)
+	}
+	// 同一用户的令牌名称唯一
+	if err := ptr.CreatePersonalToken(ctx, &biz.PersonalToken{UserID: 1, Name: "ci", TokenHash: "other"}); err == nil {
+		t.Fatal("expected duplicate name to be rejected")
+	}
+	if err := ptr.CreatePersonalToken(ctx, &biz.PersonalToken{UserID: 2, Name: "ci", TokenHash: "other"}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ptr.GetPersonalTokenByHash(ctx, "hash")
+	if err != nil || got.ID != pt.ID || len(got.Scopes) != 2 || got.ExpiresAt.Unix() != expiresAt.Unix() || !got.LastUsedAt.IsZero() {
+		t.Fatalf("unexpected token: %+v %v", got, err)
+	}
+	if err := ptr.TouchPersonalToken(ctx, pt.ID, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if ts, err := ptr.ListPersonalTokens(ctx, 1); err != nil || len(ts) != 1 || ts[0].LastUsedAt.IsZero() {
+		t.Fatalf("expected last used time to be recorded, got %+v %v", ts, err)
+	}
+	if ok, _ := ptr.DeletePersonalToken(ctx, 2, pt.ID); ok {
+		t.Fatal("expected other user's token not to be deleted")
+	}
+	if err := ptr.DeleteUserPersonalTokens(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ptr.GetPersonalTokenByHash(ctx, "hash"); !kerrors.IsNotFound(err) {
+		t.Fatalf("expected deleted token to be not found, got %v", err)
+	}
+	if ts, err := ptr.ListPersonalTokens(ctx, 2); err != nil || len(ts) != 1 {
+		t.Fatalf("expected other user's token to be kept, got %+v %v", ts, err)
+	}
+}
@@ -0,0 +1,120 @@
+package data
+
+import (
+	"context"
+	"demo/internal/biz"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// 登录会话
+type Session struct {
+	ID         int    `gorm:"primaryKey;autoIncrement"`
+	CreatedAt  int    `gorm:"not null;comment:创建时间" json:"created_at"`
+	UserID     int    `gorm:"not null;index;comment:用户ID" json:"user_id"`
+	UserAgent  string `gorm:"type:varchar(255);not null;default:'';comment:User-Agent" json:"user_agent"`
+	Device     string `gorm:"type:varchar(64);not null;default:'';comment:设备描述" json:"device"`
+	IP         string `gorm:"type:varchar(64);not null;default:'';comment:IP" json:"ip"`
+	LastSeenAt int64  `gorm:"not null;default:0;comment:最近活跃时间" json:"last_seen_at"`
+	ExpiresAt  int64  `gorm:"not null;comment:过期时间" json:"expires_at"`
+	RevokedAt  int64  `gorm:"not null;default:0;comment:退出时间" json:"revoked_at"`
+}
+
+type sessionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewSessionRepo(data *Data, logger log.Logger) biz.SessionRepo {
+	return &sessionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func toSession(po *Session) *biz.Session {
+	return &biz.Session{
+		ID:         po.ID,
+		UserID:     po.UserID,
+		UserAgent:  po.UserAgent,
+		Device:     po.Device,
+		IP:         po.IP,
+		CreatedAt:  time.Unix(int64(po.CreatedAt), 0),
+		LastSeenAt: unixOrZero(po.LastSeenAt),
+		ExpiresAt:  time.Unix(po.ExpiresAt, 0),
+		RevokedAt:  unixOrZero(po.RevokedAt),
+	}
+}
+
+func (r *sessionRepo) CreateSession(ctx context.Context, s *biz.Session) error {
+	po := &Session{
+		UserID:     s.UserID,
+		UserAgent:  s.UserAgent,
+		Device:     s.Device,
+		IP:         s.IP,
+		LastSeenAt: s.LastSeenAt.Unix(),
+		ExpiresAt:  s.ExpiresAt.Unix(),
+	}
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		return err
+	}
+	s.ID = po.ID
+	s.CreatedAt = time.Unix(int64(po.CreatedAt), 0)
+	return nil
+}
+
+func (r *sessionRepo) GetSession(ctx context.Context, id int) (*biz.Session, error) {
+	po := new(Session)
+	rv := r.data.DB(ctx).Where("id=?", id).First(po)
+	if errors.Is(rv.Error, gorm.ErrRecordNotFound) {
+		return nil, errors.NotFound("session", "not found")
+	}
+	if rv.Error != nil {
+		return nil, rv.Error
+	}
+	return toSession(po), nil
+}
+
+func (r *sessionRepo) ListActiveSessions(ctx context.Context, userId int) ([]*biz.Session, error) {
+	var pos []*Session
+	err := r.data.DB(ctx).Where("user_id=? and revoked_at=0 and expires_at>?", userId, time.Now().Unix()).
+		Order("last_seen_at desc, id desc").Find(&pos).Error
+	if err != nil {
+		return nil, err
+	}
+	rv := make([]*biz.Session, 0, len(pos))
+	for _, po := range pos {
+		rv = append(rv, toSession(po))
+	}
+	return rv, nil
+}
+
+func (r *sessionRepo) TouchSession(ctx context.Context, id int, seenAt time.Time) error {
+	return r.data.DB(ctx).Model(&Session{}).Where("id=?", id).Update("last_seen_at", seenAt.Unix()).Error
+}
+
+func (r *sessionRepo) RefreshSession(ctx context.Context, id int, ci biz.ClientInfo, seenAt, expiresAt time.Time) error {
+	values := map[string]interface{}{
+		"last_seen_at": seenAt.Unix(),
+		"expires_at":   expiresAt.Unix(),
+	}
+	// 客户端信息未知时保留原值
+	if ci.IP != "" {
+		values["ip"] = ci.IP
+	}
+	return r.data.DB(ctx).Model(&Session{}).Where("id=? and revoked_at=0", id).Updates(values).Error
+}
+
+func (r *sessionRepo) RevokeSession(ctx context.Context, userId, id int) (bool, error) {
+	rv := r.data.DB(ctx).Model(&Session{}).Where("id=? and user_id=? and revoked_at=0 and expires_at>?", id, userId, time.Now().Unix()).
+		Update("revoked_at", time.Now().Unix())
+	return rv.RowsAffected > 0, rv.Error
+}
+
+func (r *sessionRepo) RevokeUserSessions(ctx context.Context, userId, exceptId int) error {
+	return r.data.DB(ctx).Model(&Session{}).Where("user_id=? and revoked_at=0 and id<>?", userId, exceptId).
+		Update("revoked_at", time.Now().Unix()).Error
+}
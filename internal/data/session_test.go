@@ -0,0 +1,62 @@
+package data
+
+import (
+	"context"
+	"demo/internal/biz"
+	"demo/internal/conf"
+	"testing"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestSessionRepo(t *testing.T) {
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Dsn: "file:" + t.Name() + "?mode=memory&cache=shared", AutoMigrate: true}}
+	db, err := NewDB(c, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &Data{db: db}
+	sr := NewSessionRepo(d, log.DefaultLogger)
+	ctx := context.Background()
+	now := time.Now()
+	ids := []int{}
+	for i, seen := range []time.Time{now.Add(-time.Hour), now, now.Add(-time.Minute)} {
+		s := &biz.Session{UserID: 1, IP: "10.0.0.1", LastSeenAt: seen, ExpiresAt: now.Add(time.Hour)}
+		if i == 2 {
+			s.ExpiresAt = now.Add(-time.Second)
+		}
+		if err := sr.CreateSession(ctx, s); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, s.ID)
+	}
+	// 不含已过期的会话, 按最近活跃时间倒序
+	ss, err := sr.ListActiveSessions(ctx, 1)
+	if err != nil || len(ss) != 2 || ss[0].ID != ids[1] || ss[1].ID != ids[0] {
+		t.Fatalf("unexpected active sessions: %+v %v", ss, err)
+	}
+	// 客户端信息未知时保留原 IP
+	if err := sr.RefreshSession(ctx, ids[0], biz.ClientInfo{}, now.Add(time.Minute), now.Add(2*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if s, err := sr.GetSession(ctx, ids[0]); err != nil || s.IP != "10.0.0.1" || s.ExpiresAt.Unix() != now.Add(2*time.Hour).Unix() {
+		t.Fatalf("unexpected refreshed session: %+v %v", s, err)
+	}
+	if ok, _ := sr.RevokeSession(ctx, 2, ids[0]); ok {
+		t.Fatal("expected other user's session not to be revoked")
+	}
+	if ok, _ := sr.RevokeSession(ctx, 1, ids[2]); ok {
+		t.Fatal("expected expired session not to be revoked")
+	}
+	if err := sr.RevokeUserSessions(ctx, 1, ids[1]); err != nil {
+		t.Fatal(err)
+	}
+	if ss, err := sr.ListActiveSessions(ctx, 1); err != nil || len(ss) != 1 || ss[0].ID != ids[1] {
+		t.Fatalf("expected only the excepted session to remain, got %+v %v", ss, err)
+	}
+	if _, err := sr.GetSession(ctx, 1000); !kerrors.IsNotFound(err) {
+		t.Fatalf("expected unknown session to be not found, got %v", err)
+	}
+}
@@ -3,43 +3,65 @@ package data
 import (
 	"context"
 	"demo/internal/biz"
+	"time"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
+	"gorm.io/plugin/soft_delete"
 )
 
 // 文章的po
 type Article struct {
-	ID             int    `gorm:"type:int(11);primarykey;auto_increment"`
-	CreatedAt      int    `gorm:"type:int(11);not null;default:0;comment:创建时间" json:"created_at"`
-	UpdatedAt      int    `gorm:"type:int(11);not null;default:0;comment:更新时间" json:"updated_at"`
-	DeletedAt      int    `gorm:"type:int(11);not null;default:0;comment:删除时间" json:"deleted_at"`
-	Title          string `gorm:"type:varchar(64);not null;comment:文章标题" json:"title"`
-	Description    string `gorm:"type:varchar(255);not null;comment:文章描述" json:"description"`
-	Body           string `gorm:"type:varchar(511);not null;comment:文章体" json:"body"`
-	FavoritesCount int    `gorm:"type:int(11);not null;default:0;comment:赞数量" json:"favorites_count"`
-	UserID         string `gorm:"type:int(11);not null;comment:用户ID" json:"userId"`
+	ID             int                   `gorm:"primaryKey;autoIncrement"`
+	CreatedAt      int                   `gorm:"not null;comment:创建时间" json:"created_at"`
+	UpdatedAt      int                   `gorm:"not null;comment:更新时间" json:"updated_at"`
+	DeletedAt      soft_delete.DeletedAt `gorm:"not null;default:0;comment:删除时间" json:"deleted_at"`
+	Title          string                `gorm:"type:varchar(64);not null;comment:文章标题" json:"title"`
+	Slug           string                `gorm:"type:varchar(128);not null;default:'';uniqueIndex;comment:文章slug" json:"slug"`
+	Description    string                `gorm:"type:varchar(255);not null;comment:文章描述" json:"description"`
+	Body           string                `gorm:"type:varchar(511);not null;comment:文章体" json:"body"`
+	FavoritesCount int                   `gorm:"not null;default:0;comment:赞数量" json:"favorites_count"`
+	UserID         int                   `gorm:"not null;default:0;index;comment:用户ID" json:"user_id"`
 }
 
 // 评论po
 type Comment struct {
-	ID        int    `gorm:"type:int(11);primarykey;auto_increment"`
-	CreatedAt int    `gorm:"type:int(11);not null;default:0;comment:创建时间" json:"created_at"`
-	UpdatedAt int    `gorm:"type:int(11);not null;default:0;comment:更新时间" json:"updated_at"`
-	DeletedAt int    `gorm:"type:int(11);not null;default:0;comment:删除时间" json:"deleted_at"`
-	Body      string `gorm:"type:varchar(64);not null;comment:评论内容" json:"body"`
-	ArticleID int    `gorm:"type:varchar(64);not null;comment:文章ID" json:"article_id"`
-	UserID    int    `gorm:"type:int(11);not null;comment:用户ID" json:"user_id"`
+	ID        int                   `gorm:"primaryKey;autoIncrement"`
+	CreatedAt int                   `gorm:"not null;comment:创建时间" json:"created_at"`
+	UpdatedAt int                   `gorm:"not null;comment:更新时间" json:"updated_at"`
+	DeletedAt soft_delete.DeletedAt `gorm:"not null;default:0;comment:删除时间" json:"deleted_at"`
+	Body      string                `gorm:"type:varchar(64);not null;comment:评论内容" json:"body"`
+	ArticleID int                   `gorm:"not null;index;comment:文章ID" json:"article_id"`
+	UserID    int                   `gorm:"not null;default:0;comment:用户ID" json:"user_id"`
 }
 
 // 标签po
 type Tag struct {
-	ID        int    `gorm:"type:int(11);primarykey;auto_increment"`
-	CreatedAt int    `gorm:"type:int(11);not null;default:0;comment:创建时间" json:"created_at"`
-	UpdatedAt int    `gorm:"type:int(11);not null;default:0;comment:更新时间" json:"updated_at"`
-	DeletedAt int    `gorm:"type:int(11);not null;default:0;comment:删除时间" json:"deleted_at"`
-	ArticleID int    `gorm:"type:int(11);not null;comment:文章ID" json:"article_id"`
-	Tag       string `gorm:"type:varchar(64);not null; comment:标记" json:"tag"`
+	ID        int                   `gorm:"primaryKey;autoIncrement"`
+	CreatedAt int                   `gorm:"not null;comment:创建时间" json:"created_at"`
+	UpdatedAt int                   `gorm:"not null;comment:更新时间" json:"updated_at"`
+	DeletedAt soft_delete.DeletedAt `gorm:"not null;default:0;comment:删除时间" json:"deleted_at"`
+	ArticleID int                   `gorm:"not null;comment:文章ID" json:"article_id"`
+	Tag       string                `gorm:"type:varchar(64);not null; comment:标记" json:"tag"`
+}
+
+// 文章旧slug po, 标题修改后旧 slug 仍可访问
+type ArticleSlug struct {
+	ID        int    `gorm:"primaryKey;autoIncrement"`
+	CreatedAt int    `gorm:"not null;comment:创建时间" json:"created_at"`
+	Slug      string `gorm:"type:varchar(128);not null;uniqueIndex;comment:旧slug" json:"slug"`
+	ArticleID int    `gorm:"not null;index;comment:文章ID" json:"article_id"`
+}
+
+// 点赞po
+type Favorite struct {
+	ID        int `gorm:"primaryKey;autoIncrement"`
+	CreatedAt int `gorm:"not null;comment:创建时间" json:"created_at"`
+	UpdatedAt int `gorm:"not null;comment:更新时间" json:"updated_at"`
+	UserID    int `gorm:"not null;uniqueIndex:idx_user_article;comment:用户ID" json:"user_id"`
+	ArticleID int `gorm:"not null;uniqueIndex:idx_user_article;index;comment:文章ID" json:"article_id"`
 }
 
 type articleRepo struct {
@@ -57,6 +79,11 @@ type tagRepo struct {
 	log  *log.Helper
 }
 
+type favoriteRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
 func NewArticleRepo(data *Data, logger log.Logger) biz.ArticleRepo {
 	return &articleRepo{
 		data: data,
@@ -68,59 +95,234 @@ func (r *articleRepo) Create(ctx context.Context, do *biz.Article) (*biz.Article
 		Title:       do.Title,
 		Description: do.Description,
 		Body:        do.Body,
+		Slug:        do.Slug,
+		UserID:      do.Author.UserID,
+	}
+	rv := r.data.DB(ctx).Create(po)
+	if isDuplicateKey(rv.Error) {
+		return nil, errors.Conflict("slug", "already taken")
 	}
-	rv := r.data.db.Create(po)
 	do.ID = int(po.ID)
+	do.CreatedAt = time.Unix(int64(po.CreatedAt), 0)
+	do.UpdatedAt = time.Unix(int64(po.UpdatedAt), 0)
 	return do, rv.Error
 }
 
-func (r *articleRepo) List(ctx context.Context, opt ...biz.ListOption) ([]*biz.Article, error) {
+func (r *articleRepo) List(ctx context.Context, opt ...biz.ListOption) ([]*biz.Article, int64, error) {
+	opts := biz.NewListOptions(opt...)
+	query := r.data.DB(ctx).Model(&Article{})
+	if tag := opts.Filters["tag"]; tag != "" {
+		query = query.Where("id in (?)", r.data.DB(ctx).Model(&Tag{}).Select("article_id").Where("tag=?", tag))
+	}
+	if author := opts.Filters["author"]; author != "" {
+		query = query.Where("user_id in (?)", r.data.DB(ctx).Model(&User{}).Select("id").Where("username=?", author))
+	}
+	if favorited := opts.Filters["favorited"]; favorited != "" {
+		users := r.data.DB(ctx).Model(&User{}).Select("id").Where("username=?", favorited)
+		query = query.Where("id in (?)", r.data.DB(ctx).Model(&Favorite{}).Select("article_id").Where("user_id in (?)", users))
+	}
+	return r.list(ctx, query, opts)
+}
+
+func (r *articleRepo) ListFeed(ctx context.Context, userId int, opt ...biz.ListOption) ([]*biz.Article, int64, error) {
+	opts := biz.NewListOptions(opt...)
+	following := r.data.DB(ctx).Model(&Follow{}).Select("follow_id").Where("user_id=?", userId)
+	return r.list(ctx, r.data.DB(ctx).Model(&Article{}).Where("user_id in (?)", following), opts)
+}
+
+// 分页查询文章, 按发布时间倒序, 返回当页文章(含标签)与总数
+func (r *articleRepo) list(ctx context.Context, query *gorm.DB, opts *biz.ListOptions) ([]*biz.Article, int64, error) {
+	query = query.Session(&gorm.Session{})
+	var count int64
+	if rv := query.Count(&count); rv.Error != nil {
+		return nil, 0, rv.Error
+	}
 	pos := []Article{}
-	rv := r.data.db.Find(&pos)
+	rv := query.Order("created_at desc, id desc").Limit(int(opts.Limit)).Offset(int(opts.Offset)).Find(&pos)
 	if rv.Error != nil {
-		return nil, rv.Error
+		return nil, 0, rv.Error
 	}
 	dos := []*biz.Article{}
-	for _, v := range pos {
-		dos = append(dos, &biz.Article{
-			Title:          v.Title,
-			Description:    v.Description,
-			Body:           v.Body,
-			FavoritesCount: v.FavoritesCount,
-		})
+	ids := []int{}
+	for i := range pos {
+		dos = append(dos, articleToBiz(&pos[i]))
+		ids = append(ids, pos[i].ID)
+	}
+	if len(ids) == 0 {
+		return dos, count, nil
+	}
+	// 批量加载标签
+	tds := []Tag{}
+	if rv := r.data.DB(ctx).Where("article_id in ?", ids).Order("id").Find(&tds); rv.Error != nil {
+		return nil, 0, rv.Error
 	}
-	return dos, rv.Error
+	tags := make(map[int][]string)
+	for _, v := range tds {
+		tags[v.ArticleID] = append(tags[v.ArticleID], v.Tag)
+	}
+	for _, v := range dos {
+		v.TagList = tags[v.ID]
+	}
+	return dos, count, nil
 }
 
 func (r *articleRepo) Get(ctx context.Context, articleId int) (*biz.Article, error) {
 	po := new(Article)
-	rv := r.data.db.First(&po)
-	return &biz.Article{
-		Title:          po.Title,
-		Description:    po.Description,
-		Body:           po.Body,
-		FavoritesCount: po.FavoritesCount,
-	}, rv.Error
+	rv := r.data.DB(ctx).Where("id=?", articleId).First(&po)
+	if errors.Is(rv.Error, gorm.ErrRecordNotFound) {
+		return nil, errors.NotFound("article", "not found by id")
+	}
+	if rv.Error != nil {
+		return nil, rv.Error
+	}
+	return articleToBiz(po), nil
+}
+
+func (r *articleRepo) GetBySlug(ctx context.Context, slug string) (*biz.Article, error) {
+	po := new(Article)
+	rv := r.data.DB(ctx).Where("slug=?", slug).First(&po)
+	if rv.Error == nil {
+		return articleToBiz(po), nil
+	}
+	if !errors.Is(rv.Error, gorm.ErrRecordNotFound) {
+		return nil, rv.Error
+	}
+	// 查找旧 slug
+	old := new(ArticleSlug)
+	rv = r.data.DB(ctx).Where("slug=?", slug).First(&old)
+	if errors.Is(rv.Error, gorm.ErrRecordNotFound) {
+		return nil, errors.NotFound("article", "not found by slug")
+	}
+	if rv.Error != nil {
+		return nil, rv.Error
+	}
+	return r.Get(ctx, old.ArticleID)
+}
+
+func (r *articleRepo) SlugExists(ctx context.Context, slug string, excludeArticleId int) (bool, error) {
+	var count int64
+	// 已删除的文章仍占用 slug, 以便恢复
+	rv := r.data.DB(ctx).Unscoped().Model(&Article{}).Where("slug=? and id<>?", slug, excludeArticleId).Count(&count)
+	if rv.Error != nil {
+		return false, rv.Error
+	}
+	if count > 0 {
+		return true, nil
+	}
+	rv = r.data.DB(ctx).Model(&ArticleSlug{}).Where("slug=? and article_id<>?", slug, excludeArticleId).Count(&count)
+	return count > 0, rv.Error
 }
 
 func (r *articleRepo) Update(ctx context.Context, articleId int, do *biz.Article) (*biz.Article, error) {
+	// 点赞数只由 favoriteRepo 维护, 更新文章时不写入, 避免覆盖并发点赞后的计数
 	po := &Article{
-		Title:          do.Title,
-		Description:    do.Description,
-		Body:           do.Body,
-		FavoritesCount: do.FavoritesCount,
+		Title:       do.Title,
+		Description: do.Description,
+		Body:        do.Body,
+		Slug:        do.Slug,
 	}
-	r.data.db.Model(&po).Where("id=?", articleId).Updates(po)
-	return nil, nil
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		old := new(Article)
+		if err := tx.Where("id=?", articleId).First(&old).Error; err != nil {
+			return err
+		}
+		// slug 变更时保留旧 slug
+		if po.Slug != "" && po.Slug != old.Slug {
+			if err := tx.Where("slug=? and article_id=?", po.Slug, articleId).Delete(&ArticleSlug{}).Error; err != nil {
+				return err
+			}
+			if old.Slug != "" {
+				if err := tx.Create(&ArticleSlug{Slug: old.Slug, ArticleID: articleId}).Error; err != nil {
+					return err
+				}
+			}
+		}
+		return tx.Model(&Article{}).Where("id=?", articleId).Updates(po).Error
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.NotFound("article", "not found by id")
+	}
+	if isDuplicateKey(err) {
+		return nil, errors.Conflict("slug", "already taken")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r.Get(ctx, articleId)
 }
 
+// 软删除文章, 旧 slug 保留以便恢复
 func (r *articleRepo) Delete(ctx context.Context, articleId int) error {
-	var ars []Article
-	tx := r.data.db.Clauses(clause.Returning{}).Where("id = ?", articleId).Delete(&ars)
-	if len(ars) > 0 {
+	rv := r.data.DB(ctx).Where("id = ?", articleId).Delete(&Article{})
+	if rv.Error != nil {
+		return rv.Error
+	}
+	if rv.RowsAffected == 0 {
+		return errors.NotFound("article", "not found by id")
+	}
+	return nil
+}
+
+// 查询文章, 包含已删除的文章
+func (r *articleRepo) GetUnscoped(ctx context.Context, articleId int) (*biz.Article, error) {
+	po := new(Article)
+	rv := r.data.DB(ctx).Unscoped().Where("id=?", articleId).First(&po)
+	if errors.Is(rv.Error, gorm.ErrRecordNotFound) {
+		return nil, errors.NotFound("article", "not found by id")
+	}
+	if rv.Error != nil {
+		return nil, rv.Error
+	}
+	return articleToBiz(po), nil
+}
+
+// 恢复已删除的文章
+func (r *articleRepo) Restore(ctx context.Context, articleId int) error {
+	rv := r.data.DB(ctx).Unscoped().Model(&Article{}).Where("id=? and deleted_at<>0", articleId).Update("deleted_at", 0)
+	if rv.Error != nil {
+		return rv.Error
+	}
+	if rv.RowsAffected == 0 {
+		return errors.NotFound("article", "deleted article not found by id")
+	}
+	return nil
+}
+
+// 彻底删除文章及其评论、点赞与旧 slug
+func (r *articleRepo) Purge(ctx context.Context, articleId int) error {
+	return r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		rv := tx.Unscoped().Where("id = ?", articleId).Delete(&Article{})
+		if rv.Error != nil {
+			return rv.Error
+		}
+		if rv.RowsAffected == 0 {
+			return errors.NotFound("article", "not found by id")
+		}
+		for _, po := range []interface{}{&ArticleSlug{}, &Comment{}, &Favorite{}} {
+			if err := tx.Unscoped().Where("article_id = ?", articleId).Delete(po).Error; err != nil {
+				return err
+			}
+		}
 		return nil
+	})
+}
+
+func articleToBiz(po *Article) *biz.Article {
+	do := &biz.Article{
+		ID:             po.ID,
+		Title:          po.Title,
+		Description:    po.Description,
+		Body:           po.Body,
+		Slug:           po.Slug,
+		CreatedAt:      time.Unix(int64(po.CreatedAt), 0),
+		UpdatedAt:      time.Unix(int64(po.UpdatedAt), 0),
+		FavoritesCount: po.FavoritesCount,
+		Author:         biz.Author{UserID: po.UserID},
 	}
-	return tx.Error
+	if po.DeletedAt > 0 {
+		do.DeletedAt = time.Unix(int64(po.DeletedAt), 0)
+	}
+	return do
 }
 
 func NewCommentRepo(data *Data, logger log.Logger) biz.CommentRepo {
@@ -133,43 +335,89 @@ func NewCommentRepo(data *Data, logger log.Logger) biz.CommentRepo {
 func (r *commentRepo) Create(ctx context.Context, articleId int, do *biz.Comment) (*biz.Comment, error) {
 	po := &Comment{
 		Body:      do.Body,
-		ArticleID: int(do.ArticleID),
+		ArticleID: articleId,
+		UserID:    do.UserID,
 	}
-	tx := r.data.db.Create(po)
-	return &biz.Comment{
-		Body:      do.Body,
-		ArticleID: do.ArticleID,
-		Username:  do.Username,
-	}, tx.Error
+	tx := r.data.DB(ctx).Create(po)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+	return commentToBiz(po), nil
 }
 
-func (r *commentRepo) Get(ctx context.Context, articleId uint) (*biz.Comment, error) {
+func (r *commentRepo) Get(ctx context.Context, commentId uint) (*biz.Comment, error) {
 	po := &Comment{}
-	tx := r.data.db.First(po, articleId)
-	return &biz.Comment{
-		Body:      po.Body,
-		ArticleID: uint(po.ArticleID),
-	}, tx.Error
+	tx := r.data.DB(ctx).First(po, commentId)
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, errors.NotFound("comment", "not found by id")
+	}
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+	return commentToBiz(po), nil
 }
 
 func (r *commentRepo) List(ctx context.Context, articleId int) ([]*biz.Comment, error) {
 	pos := []Comment{}
-	tx := r.data.db.Find(&pos)
+	tx := r.data.DB(ctx).Where("article_id=?", articleId).Order("id").Find(&pos)
 	if tx.Error != nil {
-		return []*biz.Comment{}, nil
+		return nil, tx.Error
 	}
 	dos := []*biz.Comment{}
-	for _, v := range pos {
-		dos = append(dos, &biz.Comment{
-			Body:      v.Body,
-			ArticleID: uint(v.ArticleID),
-		})
+	for i := range pos {
+		dos = append(dos, commentToBiz(&pos[i]))
 	}
 	return dos, nil
 }
 
+// 软删除评论
 func (r *commentRepo) Delete(ctx context.Context, id uint) error {
-	return r.data.db.Delete(&Comment{}, id).Error
+	return r.data.DB(ctx).Delete(&Comment{}, id).Error
+}
+
+// 查询评论, 包含已删除的评论
+func (r *commentRepo) GetUnscoped(ctx context.Context, commentId uint) (*biz.Comment, error) {
+	po := &Comment{}
+	tx := r.data.DB(ctx).Unscoped().First(po, commentId)
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, errors.NotFound("comment", "not found by id")
+	}
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+	return commentToBiz(po), nil
+}
+
+// 恢复已删除的评论
+func (r *commentRepo) Restore(ctx context.Context, id uint) error {
+	tx := r.data.DB(ctx).Unscoped().Model(&Comment{}).Where("id=? and deleted_at<>0", id).Update("deleted_at", 0)
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return errors.NotFound("comment", "deleted comment not found by id")
+	}
+	return nil
+}
+
+// 彻底删除评论
+func (r *commentRepo) Purge(ctx context.Context, id uint) error {
+	return r.data.DB(ctx).Unscoped().Delete(&Comment{}, id).Error
+}
+
+func commentToBiz(po *Comment) *biz.Comment {
+	do := &biz.Comment{
+		ID:        uint(po.ID),
+		Body:      po.Body,
+		CreatedAt: time.Unix(int64(po.CreatedAt), 0),
+		UpdatedAt: time.Unix(int64(po.UpdatedAt), 0),
+		ArticleID: uint(po.ArticleID),
+		UserID:    po.UserID,
+	}
+	if po.DeletedAt > 0 {
+		do.DeletedAt = time.Unix(int64(po.DeletedAt), 0)
+	}
+	return do
 }
 
 func NewTagRepo(data *Data, logger log.Logger) biz.TagRepo {
@@ -186,14 +434,14 @@ func (r *tagRepo) Create(ctx context.Context, ar *biz.Article) (*biz.Article, er
 		tds = append(tds, Tag{ArticleID: int(ar.ID), Tag: v})
 		ttd = append(ttd, v)
 	}
-	rv := r.data.db.Create(tds)
+	rv := r.data.DB(ctx).Create(tds)
 	ar.TagList = ttd
 	return ar, rv.Error
 }
 
 func (r *tagRepo) Get(ctx context.Context, ar *biz.Article, arId int) (*biz.Article, error) {
 	tds := []Tag{}
-	rv := r.data.db.Where("article_id=?", arId).Find(&tds)
+	rv := r.data.DB(ctx).Where("article_id=?", arId).Find(&tds)
 	ttd := []string{}
 	for _, v := range tds {
 		ttd = append(ttd, v.Tag)
@@ -202,11 +450,96 @@ func (r *tagRepo) Get(ctx context.Context, ar *biz.Article, arId int) (*biz.Arti
 	return ar, rv.Error
 }
 
+// 软删除文章的标签
 func (r *tagRepo) Delete(ctx context.Context, arId int) error {
-	tds := []Tag{}
-	rv := r.data.db.Where("article_id=?", arId).Delete(&tds)
-	if len(tds) > 0 {
-		return nil
+	return r.data.DB(ctx).Where("article_id=?", arId).Delete(&Tag{}).Error
+}
+
+// 恢复文章已删除的标签
+func (r *tagRepo) Restore(ctx context.Context, arId int) error {
+	return r.data.DB(ctx).Unscoped().Model(&Tag{}).Where("article_id=? and deleted_at<>0", arId).Update("deleted_at", 0).Error
+}
+
+// 彻底删除文章的标签
+func (r *tagRepo) Purge(ctx context.Context, arId int) error {
+	return r.data.DB(ctx).Unscoped().Where("article_id=?", arId).Delete(&Tag{}).Error
+}
+
+func NewFavoriteRepo(data *Data, logger log.Logger) biz.FavoriteRepo {
+	return &favoriteRepo{
+		data: data,
+		log:  log.NewHelper(logger),
 	}
-	return rv.Error
+}
+
+// 点赞, 点赞关系与文章点赞数在同一事务内维护
+func (r *favoriteRepo) Favorite(ctx context.Context, userId, articleId int) (bool, error) {
+	created := false
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		rv := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&Favorite{UserID: userId, ArticleID: articleId})
+		if rv.Error != nil {
+			return rv.Error
+		}
+		if rv.RowsAffected == 0 {
+			return nil
+		}
+		created = true
+		return tx.Model(&Article{}).Where("id=?", articleId).
+			UpdateColumn("favorites_count", gorm.Expr("favorites_count + ?", 1)).Error
+	})
+	return created, err
+}
+
+// 取消点赞
+func (r *favoriteRepo) Unfavorite(ctx context.Context, userId, articleId int) (bool, error) {
+	deleted := false
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		rv := tx.Where("user_id=? and article_id=?", userId, articleId).Delete(&Favorite{})
+		if rv.Error != nil {
+			return rv.Error
+		}
+		if rv.RowsAffected == 0 {
+			return nil
+		}
+		deleted = true
+		return tx.Model(&Article{}).Where("id=? and favorites_count>0", articleId).
+			UpdateColumn("favorites_count", gorm.Expr("favorites_count - ?", 1)).Error
+	})
+	return deleted, err
+}
+
+func (r *favoriteRepo) GetFavorited(ctx context.Context, userId int, articleIds []int) (map[int]bool, error) {
+	rv := make(map[int]bool, len(articleIds))
+	if len(articleIds) == 0 {
+		return rv, nil
+	}
+	pos := []Favorite{}
+	tx := r.data.DB(ctx).Where("user_id=? and article_id in ?", userId, articleIds).Find(&pos)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+	for _, v := range pos {
+		rv[v.ArticleID] = true
+	}
+	return rv, nil
+}
+
+func (r *tagRepo) ListPopular(ctx context.Context, since time.Time, limit int) ([]*biz.TagCount, error) {
+	rows := []struct {
+		Tag           string
+		ArticlesCount int
+	}{}
+	query := r.data.DB(ctx).Model(&Tag{}).Select("tag, count(distinct article_id) as articles_count")
+	if !since.IsZero() {
+		query = query.Where("created_at >= ?", since.Unix())
+	}
+	rv := query.Group("tag").Order("articles_count desc, tag").Limit(limit).Scan(&rows)
+	if rv.Error != nil {
+		return nil, rv.Error
+	}
+	dos := []*biz.TagCount{}
+	for _, v := range rows {
+		dos = append(dos, &biz.TagCount{Tag: v.Tag, ArticlesCount: v.ArticlesCount})
+	}
+	return dos, nil
 }
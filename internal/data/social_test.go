@@ -0,0 +1,537 @@
+package data
+
+import (
+	"context"
+	"demo/internal/biz"
+	"demo/internal/conf"
+	"demo/internal/pkg/middleware/auth"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// 文章 id 列表, 便于比较顺序
+func articleIDs(ars []*biz.Article) []int {
+	ids := make([]int, 0, len(ars))
+	for _, v := range ars {
+		ids = append(ids, v.ID)
+	}
+	return ids
+}
+
+func TestArticleSlug(t *testing.T) {
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Dsn: "file:" + t.Name() + "?mode=memory&cache=shared", AutoMigrate: true}}
+	db, err := NewDB(c, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ar := NewArticleRepo(&Data{db: db}, log.DefaultLogger)
+	ctx := context.Background()
+	create := func(slug string) (*biz.Article, error) {
+		return ar.Create(ctx, &biz.Article{Title: slug, Slug: slug, Body: "body", Author: biz.Author{UserID: 1}})
+	}
+	a, err := create("hello-world")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := create("other")
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, other := a.ID, b.ID
+	// 并发写入同一 slug 时由唯一索引拒绝
+	if _, err := create("hello-world"); kerrors.Code(err) != 409 {
+		t.Fatalf("expected duplicate slug to conflict, got %v", err)
+	}
+	if _, err := ar.Update(ctx, other, &biz.Article{Slug: "hello-world"}); kerrors.Code(err) != 409 {
+		t.Fatalf("expected update to a taken slug to conflict, got %v", err)
+	}
+
+	// 修改 slug 后旧 slug 仍指向原文章, 且对其它文章视为已占用
+	if _, err := ar.Update(ctx, id, &biz.Article{Slug: "goodbye"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, slug := range []string{"hello-world", "goodbye"} {
+		if got, err := ar.GetBySlug(ctx, slug); err != nil || got.ID != id {
+			t.Fatalf("%s: expected article %d, got %+v %v", slug, id, got, err)
+		}
+	}
+	if exist, _ := ar.SlugExists(ctx, "hello-world", other); !exist {
+		t.Fatal("expected old slug to be taken for other articles")
+	}
+	if exist, _ := ar.SlugExists(ctx, "hello-world", id); exist {
+		t.Fatal("expected old slug to be reusable by its article")
+	}
+	// 改回旧 slug
+	if got, err := ar.Update(ctx, id, &biz.Article{Slug: "hello-world"}); err != nil || got.Slug != "hello-world" {
+		t.Fatalf("expected slug to be restored, got %+v %v", got, err)
+	}
+	if got, err := ar.GetBySlug(ctx, "goodbye"); err != nil || got.ID != id {
+		t.Fatalf("expected replaced slug to resolve, got %+v %v", got, err)
+	}
+	if err := ar.Purge(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ar.GetBySlug(ctx, "goodbye"); !kerrors.IsNotFound(err) {
+		t.Fatalf("expected old slugs to be purged, got %v", err)
+	}
+}
+
+func TestFeedArticles(t *testing.T) {
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Dsn: "file:" + t.Name() + "?mode=memory&cache=shared", AutoMigrate: true}}
+	db, err := NewDB(c, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &Data{db: db}
+	ar := NewArticleRepo(d, log.DefaultLogger)
+	ur := NewUserRepo(d, log.DefaultLogger)
+	ctx := context.Background()
+	reader := &biz.User{Email: "reader@b.c", Username: "reader"}
+	followed := &biz.User{Email: "followed@b.c", Username: "followed"}
+	other := &biz.User{Email: "other@b.c", Username: "other"}
+	for _, u := range []*biz.User{reader, followed, other} {
+		if err := ur.CreateUser(ctx, u); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := NewProfileRepo(d, log.DefaultLogger).FollowUser(ctx, reader.UserID, followed.UserID); err != nil {
+		t.Fatal(err)
+	}
+	// 后两篇发布时间相同, 按 id 倒序
+	want := []int{}
+	for i, createdAt := range []int{100, 300, 200, 200} {
+		for _, u := range []*biz.User{followed, other} {
+			slug := u.Username + "-" + strconv.Itoa(i)
+			a, err := ar.Create(ctx, &biz.Article{Title: slug, Slug: slug, Body: "body", Author: biz.Author{UserID: u.UserID}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := d.db.Model(&Article{}).Where("id=?", a.ID).UpdateColumn("created_at", createdAt).Error; err != nil {
+				t.Fatal(err)
+			}
+			if u == followed {
+				want = append(want, a.ID)
+			}
+		}
+	}
+	want = []int{want[1], want[3], want[2], want[0]}
+
+	ars, count, err := ar.ListFeed(ctx, reader.UserID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 || !reflect.DeepEqual(articleIDs(ars), want) {
+		t.Fatalf("got %v (count %d), want %v", articleIDs(ars), count, want)
+	}
+	ars, count, err = ar.ListFeed(ctx, reader.UserID, biz.ListLimit(2), biz.ListOffset(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 || !reflect.DeepEqual(articleIDs(ars), want[1:3]) {
+		t.Fatalf("paged: got %v (count %d), want %v", articleIDs(ars), count, want[1:3])
+	}
+	// 未关注任何人时为空
+	ars, count, err = ar.ListFeed(ctx, followed.UserID)
+	if err != nil || count != 0 || len(ars) != 0 {
+		t.Fatalf("expected empty feed, got %v (count %d) %v", articleIDs(ars), count, err)
+	}
+}
+
+func TestListArticlesFilters(t *testing.T) {
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Dsn: "file:" + t.Name() + "?mode=memory&cache=shared", AutoMigrate: true}}
+	db, err := NewDB(c, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &Data{db: db}
+	ar := NewArticleRepo(d, log.DefaultLogger)
+	tr := NewTagRepo(d, log.DefaultLogger)
+	fr := NewFavoriteRepo(d, log.DefaultLogger)
+	ur := NewUserRepo(d, log.DefaultLogger)
+	ctx := context.Background()
+	a := &biz.User{Email: "a@b.c", Username: "a"}
+	b := &biz.User{Email: "b@b.c", Username: "b"}
+	fan := &biz.User{Email: "fan@b.c", Username: "fan"}
+	for _, u := range []*biz.User{a, b, fan} {
+		if err := ur.CreateUser(ctx, u); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// 发布时间相同, 按 id 倒序
+	create := func(u *biz.User, slug string, tags ...string) int {
+		do, err := ar.Create(ctx, &biz.Article{Title: slug, Slug: slug, Body: "body", Author: biz.Author{UserID: u.UserID}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tr.Create(ctx, &biz.Article{ID: do.ID, TagList: tags}); err != nil {
+			t.Fatal(err)
+		}
+		if err := d.db.Model(&Article{}).Where("id=?", do.ID).UpdateColumn("created_at", 100).Error; err != nil {
+			t.Fatal(err)
+		}
+		return do.ID
+	}
+	a1 := create(a, "a1", "go", "db")
+	a2 := create(a, "a2", "go")
+	a3 := create(a, "a3", "rust")
+	b1 := create(b, "b1", "go")
+	b2 := create(b, "b2", "db")
+	for _, id := range []int{a1, b1, b2} {
+		if _, err := fr.Favorite(ctx, fan.UserID, id); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, c := range []struct {
+		filter map[string]string
+		want   []int
+	}{
+		{map[string]string{"tag": "go"}, []int{b1, a2, a1}},
+		{map[string]string{"author": "a"}, []int{a3, a2, a1}},
+		{map[string]string{"favorited": "fan"}, []int{b2, b1, a1}},
+		{map[string]string{"tag": "go", "author": "a"}, []int{a2, a1}},
+		{map[string]string{"tag": "go", "favorited": "fan"}, []int{b1, a1}},
+		{map[string]string{"author": "b", "favorited": "fan"}, []int{b2, b1}},
+		{map[string]string{"author": "nobody"}, []int{}},
+	} {
+		ars, count, err := ar.List(ctx, biz.ListFilter(c.filter))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != int64(len(c.want)) || !reflect.DeepEqual(articleIDs(ars), c.want) {
+			t.Fatalf("%v: got %v (count %d), want %v", c.filter, articleIDs(ars), count, c.want)
+		}
+	}
+	// 总数为过滤后的数量而非当页数量
+	ars, count, err := ar.List(ctx, biz.ListFilter(map[string]string{"tag": "go"}), biz.ListLimit(1), biz.ListOffset(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 3 || !reflect.DeepEqual(articleIDs(ars), []int{a2}) {
+		t.Fatalf("paged: got %v (count %d)", articleIDs(ars), count)
+	}
+	if !reflect.DeepEqual(ars[0].TagList, []string{"go"}) {
+		t.Fatalf("expected tags to be loaded, got %v", ars[0].TagList)
+	}
+}
+
+func TestListTags(t *testing.T) {
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Dsn: "file:" + t.Name() + "?mode=memory&cache=shared", AutoMigrate: true}}
+	db, err := NewDB(c, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := NewTagRepo(&Data{db: db}, log.DefaultLogger)
+	ctx := context.Background()
+	// 同一文章重复的标签只计一次, 第 4 篇文章的标签在 30 天前创建
+	for id, tags := range [][]string{{"go", "go", "db"}, {"go", "rust"}, {"db"}, {"rust", "old"}} {
+		if _, err := tr.Create(ctx, &biz.Article{ID: id + 1, TagList: tags}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.Model(&Tag{}).Where("article_id=?", 4).UpdateColumn("created_at", time.Now().AddDate(0, 0, -30).Unix()).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []struct {
+		since time.Time
+		limit int
+		want  []biz.TagCount
+	}{
+		{time.Time{}, 10, []biz.TagCount{{Tag: "db", ArticlesCount: 2}, {Tag: "go", ArticlesCount: 2}, {Tag: "rust", ArticlesCount: 2}, {Tag: "old", ArticlesCount: 1}}},
+		{time.Now().AddDate(0, 0, -7), 10, []biz.TagCount{{Tag: "db", ArticlesCount: 2}, {Tag: "go", ArticlesCount: 2}, {Tag: "rust", ArticlesCount: 1}}},
+		{time.Time{}, 1, []biz.TagCount{{Tag: "db", ArticlesCount: 2}}},
+	} {
+		tags, err := tr.ListPopular(ctx, c.since, c.limit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := []biz.TagCount{}
+		for _, v := range tags {
+			got = append(got, *v)
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Fatalf("since %v limit %d: got %v, want %v", c.since, c.limit, got, c.want)
+		}
+	}
+}
+
+// 创建记录时自动填充 created_at, 按时间统计标签和按发布时间排序都依赖它
+func TestCreatedAt(t *testing.T) {
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Dsn: "file:" + t.Name() + "?mode=memory&cache=shared", AutoMigrate: true}}
+	db, err := NewDB(c, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &Data{db: db}
+	ctx := context.Background()
+	u := &biz.User{Email: "a@b.c", Username: "a"}
+	if err := NewUserRepo(d, log.DefaultLogger).CreateUser(ctx, u); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewProfileRepo(d, log.DefaultLogger).FollowUser(ctx, u.UserID, u.UserID+1); err != nil {
+		t.Fatal(err)
+	}
+	ar, err := NewArticleRepo(d, log.DefaultLogger).Create(ctx, &biz.Article{Title: "t", Slug: "t", Body: "b", Author: biz.Author{UserID: u.UserID}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ar.CreatedAt.Unix() <= 0 {
+		t.Fatalf("expected created article to carry created_at, got %v", ar.CreatedAt)
+	}
+	if _, err := NewTagRepo(d, log.DefaultLogger).Create(ctx, &biz.Article{ID: ar.ID, TagList: []string{"go"}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewCommentRepo(d, log.DefaultLogger).Create(ctx, ar.ID, &biz.Comment{Body: "c", UserID: u.UserID}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewFavoriteRepo(d, log.DefaultLogger).Favorite(ctx, u.UserID, ar.ID); err != nil {
+		t.Fatal(err)
+	}
+	for _, po := range []interface{}{&User{}, &Follow{}, &Article{}, &Tag{}, &Comment{}, &Favorite{}} {
+		var total, zero int64
+		if err := db.Model(po).Count(&total).Error; err != nil {
+			t.Fatal(err)
+		}
+		if err := db.Model(po).Where("created_at = 0").Count(&zero).Error; err != nil {
+			t.Fatal(err)
+		}
+		if total == 0 || zero != 0 {
+			t.Fatalf("%T: %d of %d rows without created_at", po, zero, total)
+		}
+	}
+}
+
+func TestFavoriteArticle(t *testing.T) {
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Dsn: "file:" + t.Name() + "?mode=memory&cache=shared", AutoMigrate: true}}
+	db, err := NewDB(c, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &Data{db: db}
+	ar := NewArticleRepo(d, log.DefaultLogger)
+	fr := NewFavoriteRepo(d, log.DefaultLogger)
+	ctx := context.Background()
+	a, err := ar.Create(ctx, &biz.Article{Title: "title", Slug: "title", Body: "body", Author: biz.Author{UserID: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := a.ID
+	// 检查返回值与库中计数一致
+	check := func(changed bool, err error, wantChanged bool, count int) {
+		t.Helper()
+		if err != nil || changed != wantChanged {
+			t.Fatalf("got changed %v %v, want %v", changed, err, wantChanged)
+		}
+		got, err := ar.Get(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var rows int64
+		if err := d.db.Model(&Favorite{}).Where("article_id=?", id).Count(&rows).Error; err != nil {
+			t.Fatal(err)
+		}
+		if got.FavoritesCount != count || rows != int64(count) {
+			t.Fatalf("stored count %d, %d rows, want %d", got.FavoritesCount, rows, count)
+		}
+	}
+	ok, err := fr.Favorite(ctx, 2, id)
+	check(ok, err, true, 1)
+	// 重复点赞不重复计数
+	ok, err = fr.Favorite(ctx, 2, id)
+	check(ok, err, false, 1)
+	ok, err = fr.Favorite(ctx, 3, id)
+	check(ok, err, true, 2)
+	ok, err = fr.Unfavorite(ctx, 2, id)
+	check(ok, err, true, 1)
+	ok, err = fr.Unfavorite(ctx, 2, id)
+	check(ok, err, false, 1)
+	if favorited, err := fr.GetFavorited(ctx, 3, []int{id, id + 1}); err != nil || !reflect.DeepEqual(favorited, map[int]bool{id: true}) {
+		t.Fatalf("unexpected favorited: %v %v", favorited, err)
+	}
+
+	// 编辑文章时带上读取时的旧计数, 不能覆盖之后的点赞
+	stale, err := ar.Get(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, err = fr.Favorite(ctx, 4, id)
+	check(ok, err, true, 2)
+	if _, err := ar.Update(ctx, id, &biz.Article{Title: "edited", FavoritesCount: stale.FavoritesCount}); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := ar.Get(ctx, id); err != nil || got.Title != "edited" || got.FavoritesCount != 2 {
+		t.Fatalf("expected edit to keep favorites count, got %+v %v", got, err)
+	}
+}
+
+func TestCommentRepo(t *testing.T) {
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Dsn: "file:" + t.Name() + "?mode=memory&cache=shared", AutoMigrate: true}}
+	db, err := NewDB(c, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &Data{db: db}
+	cr := NewCommentRepo(d, log.DefaultLogger)
+	ctx := context.Background()
+	articles := []int{}
+	for _, title := range []string{"title", "other"} {
+		ar, err := NewArticleRepo(d, log.DefaultLogger).Create(ctx, &biz.Article{Title: title, Slug: title, Body: "body", Author: biz.Author{UserID: 1}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		articles = append(articles, ar.ID)
+	}
+	id, other := articles[0], articles[1]
+	ids := []uint{}
+	for _, articleId := range []int{id, other, id} {
+		c, err := cr.Create(ctx, articleId, &biz.Comment{Body: "comment", UserID: 2})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c.ArticleID != uint(articleId) || c.UserID != 2 {
+			t.Fatalf("unexpected comment: %+v", c)
+		}
+		ids = append(ids, c.ID)
+	}
+	// 只列出该文章的评论, 按 id 排序
+	list := func() []uint {
+		cs, err := cr.List(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rv := []uint{}
+		for _, c := range cs {
+			rv = append(rv, c.ID)
+		}
+		return rv
+	}
+	if got := list(); !reflect.DeepEqual(got, []uint{ids[0], ids[2]}) {
+		t.Fatalf("got comments %v", got)
+	}
+	// 软删除的评论不再列出, 恢复后重新出现
+	if err := cr.Delete(ctx, ids[0]); err != nil {
+		t.Fatal(err)
+	}
+	if got := list(); !reflect.DeepEqual(got, []uint{ids[2]}) {
+		t.Fatalf("expected deleted comment to be hidden, got %v", got)
+	}
+	if _, err := cr.Get(ctx, ids[0]); !kerrors.IsNotFound(err) {
+		t.Fatalf("expected deleted comment to be not found, got %v", err)
+	}
+	if c, err := cr.GetUnscoped(ctx, ids[0]); err != nil || c.DeletedAt.IsZero() {
+		t.Fatalf("expected deleted comment, got %+v %v", c, err)
+	}
+	if err := cr.Restore(ctx, ids[0]); err != nil {
+		t.Fatal(err)
+	}
+	if got := list(); !reflect.DeepEqual(got, []uint{ids[0], ids[2]}) {
+		t.Fatalf("expected restored comment, got %v", got)
+	}
+	if err := cr.Purge(ctx, ids[0]); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cr.GetUnscoped(ctx, ids[0]); !kerrors.IsNotFound(err) {
+		t.Fatalf("expected purged comment to be not found, got %v", err)
+	}
+}
+
+// 加载作者、关注与点赞状态的查询次数不随文章和评论数量增长
+func TestArticleAuthorsQueryCount(t *testing.T) {
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Dsn: "file:" + t.Name() + "?mode=memory&cache=shared", AutoMigrate: true}}
+	db, err := NewDB(c, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &Data{db: db}
+	ur := NewUserRepo(d, log.DefaultLogger)
+	pr := NewProfileRepo(d, log.DefaultLogger)
+	fr := NewFavoriteRepo(d, log.DefaultLogger)
+	sc := biz.NewSocialUseCase(NewArticleRepo(d, log.DefaultLogger), NewCommentRepo(d, log.DefaultLogger), NewTagRepo(d, log.DefaultLogger), fr, ur, pr, biz.NewAuthorizer(), d, log.DefaultLogger)
+	ctx := context.Background()
+	viewer := &biz.User{Email: "viewer@b.c", Username: "viewer", Role: auth.RoleUser}
+	if err := ur.CreateUser(ctx, viewer); err != nil {
+		t.Fatal(err)
+	}
+	viewerCtx := auth.NewContext(ctx, auth.LoginUser{UserID: viewer.UserID, Username: viewer.Username, Role: viewer.Role})
+	// 每篇文章与评论的作者都不同, 作者均被关注, 文章均被点赞
+	articles := []int{}
+	for i := 0; i < 6; i++ {
+		u := &biz.User{Email: "u" + strconv.Itoa(i) + "@b.c", Username: "u" + strconv.Itoa(i), Role: auth.RoleUser}
+		if err := ur.CreateUser(ctx, u); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := pr.FollowUser(ctx, viewer.UserID, u.UserID); err != nil {
+			t.Fatal(err)
+		}
+		uctx := auth.NewContext(ctx, auth.LoginUser{UserID: u.UserID, Username: u.Username, Role: u.Role})
+		ar, err := sc.CreateArticle(uctx, &biz.Article{Title: "title", Body: "body", TagList: []string{"go"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := fr.Favorite(ctx, viewer.UserID, ar.ID); err != nil {
+			t.Fatal(err)
+		}
+		articles = append(articles, ar.ID)
+		// 第一篇文章有 6 条评论, 其余各 1 条
+		targets := []int{articles[0]}
+		if i > 0 {
+			targets = append(targets, ar.ID)
+		}
+		for _, articleId := range targets {
+			if _, err := sc.AddComment(uctx, articleId, "comment"); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	queries := 0
+	count := func(*gorm.DB) { queries++ }
+	if err := db.Callback().Query().After("gorm:query").Register("test:count_query", count); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Callback().Row().After("gorm:row").Register("test:count_row", count); err != nil {
+		t.Fatal(err)
+	}
+	defer db.Callback().Query().Remove("test:count_query")
+	defer db.Callback().Row().Remove("test:count_row")
+	measure := func(fn func() error) int {
+		t.Helper()
+		queries = 0
+		if err := fn(); err != nil {
+			t.Fatal(err)
+		}
+		return queries
+	}
+
+	listArticles := func(limit int64) int {
+		return measure(func() error {
+			ars, _, err := sc.ListArticles(viewerCtx, biz.ListLimit(limit))
+			if err == nil && (int64(len(ars)) != limit || !ars[0].Author.Following || !ars[0].Favorited) {
+				t.Fatalf("unexpected articles: %+v", ars)
+			}
+			return err
+		})
+	}
+	if one, all := listArticles(1), listArticles(6); one == 0 || one != all {
+		t.Fatalf("listing 6 articles took %d queries, 1 article took %d", all, one)
+	}
+	listComments := func(articleId, want int) int {
+		return measure(func() error {
+			cs, err := sc.ListComments(viewerCtx, articleId)
+			if err == nil && (len(cs) != want || !cs[0].Author.Following) {
+				t.Fatalf("unexpected comments: %+v", cs)
+			}
+			return err
+		})
+	}
+	if one, all := listComments(articles[1], 1), listComments(articles[0], 6); one != all {
+		t.Fatalf("listing 6 comments took %d queries, 1 comment took %d", all, one)
+	}
+}
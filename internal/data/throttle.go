@@ -0,0 +1,217 @@
+package data
+
+import (
+	"context"
+	"demo/internal/biz"
+	"demo/internal/conf"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 登录失败计数表, 多实例部署时共享
+type LoginAttempt struct {
+	ID           int    `gorm:"primaryKey;autoIncrement"`
+	UpdatedAt    int    `gorm:"not null;comment:更新时间" json:"updated_at"`
+	AttemptKey   string `gorm:"type:varchar(191);not null;uniqueIndex;comment:account:邮箱 或 ip:地址" json:"attempt_key"`
+	Failures     int    `gorm:"not null;default:0;comment:失败次数" json:"failures"`
+	LastFailedAt int64  `gorm:"not null;default:0;comment:最近失败时间" json:"last_failed_at"`
+	LockedUntil  int64  `gorm:"not null;default:0;comment:锁定截止时间" json:"locked_until"`
+	Lockouts     int    `gorm:"not null;default:0;comment:累计锁定次数" json:"lockouts"`
+}
+
+// 账号登录失败的来源 IP, 用于判断是否锁定账号, 解锁账号时一并清除这些 IP 的计数
+type LoginAttemptIP struct {
+	ID           int    `gorm:"primaryKey;autoIncrement"`
+	AttemptKey   string `gorm:"type:varchar(191);not null;uniqueIndex:idx_login_attempt_ips_key_ip;comment:account:邮箱" json:"attempt_key"`
+	IP           string `gorm:"type:varchar(64);not null;uniqueIndex:idx_login_attempt_ips_key_ip;comment:来源IP" json:"ip"`
+	LastFailedAt int64  `gorm:"not null;default:0;comment:最近失败时间" json:"last_failed_at"`
+}
+
+// NewLoginAttemptRepo 按配置选择内存或数据库存储
+func NewLoginAttemptRepo(c *conf.LoginThrottle, data *Data, logger log.Logger) (biz.LoginAttemptRepo, error) {
+	switch c.GetStore() {
+	case "", "memory":
+		return NewMemoryLoginAttemptRepo(), nil
+	case "database":
+		return &loginAttemptRepo{data: data, log: log.NewHelper(logger)}, nil
+	}
+	return nil, fmt.Errorf("unsupported login throttle store: %s", c.GetStore())
+}
+
+type loginAttemptRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func (r *loginAttemptRepo) Get(ctx context.Context, key string) (*biz.LoginAttempt, error) {
+	po := new(LoginAttempt)
+	rv := r.data.DB(ctx).Where("attempt_key=?", key).First(po)
+	if errors.Is(rv.Error, gorm.ErrRecordNotFound) {
+		return &biz.LoginAttempt{}, nil
+	}
+	if rv.Error != nil {
+		return nil, rv.Error
+	}
+	a := &biz.LoginAttempt{Failures: po.Failures, Lockouts: po.Lockouts}
+	if po.LockedUntil > 0 {
+		a.LockedUntil = time.Unix(po.LockedUntil, 0)
+	}
+	var ips []*LoginAttemptIP
+	if err := r.data.DB(ctx).Where("attempt_key=?", key).Find(&ips).Error; err != nil {
+		return nil, err
+	}
+	if len(ips) > 0 {
+		a.IPs = make(map[string]time.Time, len(ips))
+		for _, ip := range ips {
+			a.IPs[ip.IP] = time.Unix(ip.LastFailedAt, 0)
+		}
+	}
+	return a, nil
+}
+
+// 单条 update 语句完成计数, 多实例并发时不丢失
+func (r *loginAttemptRepo) Incr(ctx context.Context, key, ip string, window time.Duration) (*biz.LoginAttempt, error) {
+	db := r.data.DB(ctx)
+	now := time.Now()
+	err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&LoginAttempt{AttemptKey: key}).Error
+	if err != nil {
+		return nil, err
+	}
+	err = db.Model(&LoginAttempt{}).Where("attempt_key=?", key).Updates(map[string]interface{}{
+		"failures":       gorm.Expr("CASE WHEN last_failed_at < ? THEN 1 ELSE failures + 1 END", now.Add(-window).Unix()),
+		"last_failed_at": now.Unix(),
+	}).Error
+	if err != nil {
+		return nil, err
+	}
+	if ip != "" {
+		err = db.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "attempt_key"}, {Name: "ip"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{"last_failed_at": now.Unix()}),
+		}).Create(&LoginAttemptIP{AttemptKey: key, IP: ip, LastFailedAt: now.Unix()}).Error
+		if err != nil {
+			return nil, err
+		}
+	}
+	return r.Get(ctx, key)
+}
+
+func (r *loginAttemptRepo) Lock(ctx context.Context, key string, until time.Time, lockouts int) error {
+	return r.data.DB(ctx).Model(&LoginAttempt{}).Where("attempt_key=?", key).Updates(map[string]interface{}{
+		"locked_until": until.Unix(),
+		"lockouts":     lockouts,
+	}).Error
+}
+
+func (r *loginAttemptRepo) Reset(ctx context.Context, key string) error {
+	db := r.data.DB(ctx)
+	if err := db.Where("attempt_key=?", key).Delete(&LoginAttemptIP{}).Error; err != nil {
+		return err
+	}
+	return db.Where("attempt_key=?", key).Delete(&LoginAttempt{}).Error
+}
+
+const (
+	// 超过该数量时清理已过期的计数, 避免大量 IP 占用内存
+	memoryAttemptPruneSize = 10000
+	// 锁定结束后保留锁定等级的时长, 清理时超过该时长才丢弃
+	memoryLockoutRetention = 24 * time.Hour
+)
+
+type memoryAttempt struct {
+	failures    int
+	lockouts    int
+	lastFailed  time.Time
+	lockedUntil time.Time
+	window      time.Duration
+	ips         map[string]time.Time
+}
+
+func (a *memoryAttempt) state() *biz.LoginAttempt {
+	la := &biz.LoginAttempt{Failures: a.failures, Lockouts: a.lockouts, LockedUntil: a.lockedUntil}
+	if len(a.ips) > 0 {
+		la.IPs = make(map[string]time.Time, len(a.ips))
+		for ip, t := range a.ips {
+			la.IPs[ip] = t
+		}
+	}
+	return la
+}
+
+// MemoryLoginAttemptRepo 进程内的失败计数, 仅适用于单实例部署
+type MemoryLoginAttemptRepo struct {
+	mu       sync.Mutex
+	attempts map[string]*memoryAttempt
+}
+
+func NewMemoryLoginAttemptRepo() *MemoryLoginAttemptRepo {
+	return &MemoryLoginAttemptRepo{attempts: make(map[string]*memoryAttempt)}
+}
+
+func (r *MemoryLoginAttemptRepo) Get(ctx context.Context, key string) (*biz.LoginAttempt, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	a, ok := r.attempts[key]
+	if !ok {
+		return &biz.LoginAttempt{}, nil
+	}
+	return a.state(), nil
+}
+
+func (r *MemoryLoginAttemptRepo) Incr(ctx context.Context, key, ip string, window time.Duration) (*biz.LoginAttempt, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	if len(r.attempts) > memoryAttemptPruneSize {
+		r.prune(now)
+	}
+	a, ok := r.attempts[key]
+	if !ok {
+		a = &memoryAttempt{}
+		r.attempts[key] = a
+	}
+	if now.Sub(a.lastFailed) > window {
+		a.failures = 0
+	}
+	a.failures++
+	a.lastFailed = now
+	a.window = window
+	if ip != "" {
+		if a.ips == nil {
+			a.ips = make(map[string]time.Time)
+		}
+		a.ips[ip] = now
+	}
+	return a.state(), nil
+}
+
+func (r *MemoryLoginAttemptRepo) prune(now time.Time) {
+	for key, a := range r.attempts {
+		if now.Sub(a.lastFailed) > a.window && now.After(a.lockedUntil) && (a.lockouts == 0 || now.Sub(a.lockedUntil) > memoryLockoutRetention) {
+			delete(r.attempts, key)
+		}
+	}
+}
+
+func (r *MemoryLoginAttemptRepo) Lock(ctx context.Context, key string, until time.Time, lockouts int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if a, ok := r.attempts[key]; ok {
+		a.lockedUntil = until
+		a.lockouts = lockouts
+	}
+	return nil
+}
+
+func (r *MemoryLoginAttemptRepo) Reset(ctx context.Context, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.attempts, key)
+	return nil
+}
@@ -0,0 +1,55 @@
+package data
+
+import (
+	"context"
+	"demo/internal/conf"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestLoginAttemptRepo(t *testing.T) {
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Dsn: "file:" + t.Name() + "?mode=memory&cache=shared", AutoMigrate: true}}
+	db, err := NewDB(c, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &Data{db: db}
+	for _, store := range []string{"memory", "database"} {
+		repo, err := NewLoginAttemptRepo(&conf.LoginThrottle{Store: store}, d, log.DefaultLogger)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx := context.Background()
+		for i, ip := range []string{"1.1.1.1", "2.2.2.2", "1.1.1.1"} {
+			if a, err := repo.Incr(ctx, "account:a@b.c", ip, time.Hour); err != nil || a.Failures != i+1 {
+				t.Fatalf("%s: expected %d failures, got %+v %v", store, i+1, a, err)
+			}
+		}
+		until := time.Now().Add(time.Minute)
+		if err := repo.Lock(ctx, "account:a@b.c", until, 2); err != nil {
+			t.Fatal(err)
+		}
+		a, err := repo.Get(ctx, "account:a@b.c")
+		if err != nil || a.LockedUntil.Unix() != until.Unix() || a.Lockouts != 2 {
+			t.Fatalf("%s: expected lock, got %+v %v", store, a, err)
+		}
+		if _, ok := a.IPs["2.2.2.2"]; len(a.IPs) != 2 || !ok {
+			t.Fatalf("%s: expected two source ips, got %v", store, a.IPs)
+		}
+		// 距上次失败超过 window 时重新计数, 锁定次数保留
+		if a, err := repo.Incr(ctx, "account:a@b.c", "", -time.Second); err != nil || a.Failures != 1 || a.Lockouts != 2 {
+			t.Fatalf("%s: expected count to restart, got %+v %v", store, a, err)
+		}
+		if err := repo.Reset(ctx, "account:a@b.c"); err != nil {
+			t.Fatal(err)
+		}
+		if a, err := repo.Get(ctx, "account:a@b.c"); err != nil || a.Failures != 0 || a.Lockouts != 0 || !a.LockedUntil.IsZero() || len(a.IPs) != 0 {
+			t.Fatalf("%s: expected reset, got %+v %v", store, a, err)
+		}
+	}
+	if _, err := NewLoginAttemptRepo(&conf.LoginThrottle{Store: "redis"}, d, log.DefaultLogger); err == nil {
+		t.Fatal("expected unsupported store to be rejected")
+	}
+}
@@ -0,0 +1,139 @@
+package data
+
+import (
+	"context"
+	"demo/internal/biz"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// refresh token 表, 只保存 token 的哈希
+type RefreshToken struct {
+	ID        int    `gorm:"primaryKey;autoIncrement"`
+	CreatedAt int    `gorm:"not null;comment:创建时间" json:"created_at"`
+	UserID    int    `gorm:"not null;index;comment:用户ID" json:"user_id"`
+	SessionID int    `gorm:"not null;default:0;comment:会话ID" json:"session_id"`
+	TokenHash string `gorm:"type:varchar(64);not null;uniqueIndex;comment:token sha256" json:"token_hash"`
+	ExpiresAt int64  `gorm:"not null;comment:过期时间" json:"expires_at"`
+	RevokedAt int64  `gorm:"not null;default:0;comment:吊销时间" json:"revoked_at"`
+}
+
+// 已吊销的 access token 黑名单
+type RevokedToken struct {
+	ID        int    `gorm:"primaryKey;autoIncrement"`
+	CreatedAt int    `gorm:"not null;comment:创建时间" json:"created_at"`
+	Jti       string `gorm:"type:varchar(64);not null;uniqueIndex;comment:token ID" json:"jti"`
+	ExpiresAt int64  `gorm:"not null;index;comment:token 过期时间, 过期后可清理" json:"expires_at"`
+}
+
+type tokenRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewTokenRepo(data *Data, logger log.Logger) biz.TokenRepo {
+	return &tokenRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *tokenRepo) CreateRefreshToken(ctx context.Context, t *biz.RefreshToken) error {
+	po := &RefreshToken{
+		UserID:    t.UserID,
+		SessionID: t.SessionID,
+		TokenHash: t.TokenHash,
+		ExpiresAt: t.ExpiresAt.Unix(),
+	}
+	if err := r.data.DB(ctx).Create(po).Error; err != nil {
+		return err
+	}
+	t.ID = po.ID
+	return nil
+}
+
+func (r *tokenRepo) GetRefreshToken(ctx context.Context, tokenHash string) (*biz.RefreshToken, error) {
+	po := new(RefreshToken)
+	rv := r.data.DB(ctx).Where("token_hash=?", tokenHash).First(po)
+	if errors.Is(rv.Error, gorm.ErrRecordNotFound) {
+		return nil, errors.NotFound("refresh_token", "not found")
+	}
+	if rv.Error != nil {
+		return nil, rv.Error
+	}
+	do := &biz.RefreshToken{
+		ID:        po.ID,
+		UserID:    po.UserID,
+		SessionID: po.SessionID,
+		TokenHash: po.TokenHash,
+		ExpiresAt: time.Unix(po.ExpiresAt, 0),
+	}
+	if po.RevokedAt > 0 {
+		do.RevokedAt = time.Unix(po.RevokedAt, 0)
+	}
+	return do, nil
+}
+
+func (r *tokenRepo) RevokeRefreshToken(ctx context.Context, id int) (bool, error) {
+	rv := r.data.DB(ctx).Model(&RefreshToken{}).Where("id=? and revoked_at=0", id).Update("revoked_at", time.Now().Unix())
+	return rv.RowsAffected > 0, rv.Error
+}
+
+func (r *tokenRepo) RevokeUserRefreshTokens(ctx context.Context, userId int) error {
+	return r.data.DB(ctx).Model(&RefreshToken{}).Where("user_id=? and revoked_at=0", userId).Update("revoked_at", time.Now().Unix()).Error
+}
+
+// 加入黑名单, 同时清理已过期的记录
+func (r *tokenRepo) RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	db := r.data.DB(ctx)
+	if err := db.Where("expires_at<?", time.Now().Unix()).Delete(&RevokedToken{}).Error; err != nil {
+		return err
+	}
+	return db.Clauses(clause.OnConflict{DoNothing: true}).Create(&RevokedToken{Jti: jti, ExpiresAt: expiresAt.Unix()}).Error
+}
+
+// 以用户表关联会话表, 子查询黑名单, 一次查询完成 access token 的校验
+func (r *tokenRepo) GetTokenState(ctx context.Context, userId int, jti string, sessionId int) (*biz.TokenState, error) {
+	var row struct {
+		TokenVersion int
+		Revoked      bool
+		SessionID    int
+		LastSeenAt   int64
+		ExpiresAt    int64
+		RevokedAt    int64
+	}
+	rv := r.data.DB(ctx).Model(&User{}).
+		Select("users.token_version, EXISTS (SELECT 1 FROM revoked_tokens WHERE jti=?) AS revoked, "+
+			"COALESCE(sessions.id, 0) AS session_id, COALESCE(sessions.last_seen_at, 0) AS last_seen_at, "+
+			"COALESCE(sessions.expires_at, 0) AS expires_at, COALESCE(sessions.revoked_at, 0) AS revoked_at", jti).
+		Joins("LEFT JOIN sessions ON sessions.id=? AND sessions.user_id=users.id", sessionId).
+		Where("users.id=?", userId).Limit(1).Scan(&row)
+	if rv.Error != nil {
+		return nil, rv.Error
+	}
+	if rv.RowsAffected == 0 {
+		return nil, errors.NotFound("user", "not found by id")
+	}
+	st := &biz.TokenState{Version: row.TokenVersion, Revoked: row.Revoked}
+	if row.SessionID != 0 {
+		st.Session = toSession(&Session{ID: row.SessionID, UserID: userId, LastSeenAt: row.LastSeenAt, ExpiresAt: row.ExpiresAt, RevokedAt: row.RevokedAt})
+	}
+	return st, nil
+}
+
+func (r *tokenRepo) GetTokenVersion(ctx context.Context, userId int) (int, error) {
+	u := new(User)
+	rv := r.data.DB(ctx).Select("token_version").Where("id=?", userId).First(u)
+	if errors.Is(rv.Error, gorm.ErrRecordNotFound) {
+		return 0, errors.NotFound("user", "not found by id")
+	}
+	return u.TokenVersion, rv.Error
+}
+
+func (r *tokenRepo) IncrTokenVersion(ctx context.Context, userId int) error {
+	return r.data.DB(ctx).Model(&User{}).Where("id=?", userId).Update("token_version", gorm.Expr("token_version + 1")).Error
+}
@@ -0,0 +1,158 @@
+package data
+
+import (
+	"context"
+	"demo/internal/biz"
+	"demo/internal/conf"
+	"testing"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+func TestTokenRepo(t *testing.T) {
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Dsn: "file:" + t.Name() + "?mode=memory&cache=shared", AutoMigrate: true}}
+	db, err := NewDB(c, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &Data{db: db}
+	tr := NewTokenRepo(d, log.DefaultLogger)
+	atr := NewActionTokenRepo(d, log.DefaultLogger)
+	ctx := context.Background()
+	u := &biz.User{Email: "a@b.c", Username: "a"}
+	if err := NewUserRepo(d, log.DefaultLogger).CreateUser(ctx, u); err != nil {
+		t.Fatal(err)
+	}
+
+	rt := &biz.RefreshToken{UserID: u.UserID, TokenHash: "hash", ExpiresAt: time.Now().Add(time.Hour)}
+	if err := tr.CreateRefreshToken(ctx, rt); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := tr.RevokeRefreshToken(ctx, rt.ID); !ok || err != nil {
+		t.Fatalf("revoke: %v %v", ok, err)
+	}
+	if ok, err := tr.RevokeRefreshToken(ctx, rt.ID); ok || err != nil {
+		t.Fatalf("expected revoked token to be revoked only once, got %v %v", ok, err)
+	}
+	if got, err := tr.GetRefreshToken(ctx, "hash"); err != nil || got.RevokedAt.IsZero() {
+		t.Fatalf("expected revoked token, got %+v %v", got, err)
+	}
+	if err := tr.RevokeAccessToken(ctx, "jti", time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	// 重复吊销不报错
+	if err := tr.RevokeAccessToken(ctx, "jti", time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if st, err := tr.GetTokenState(ctx, u.UserID, "jti", 0); err != nil || !st.Revoked {
+		t.Fatalf("expected access token to be revoked, got %+v %v", st, err)
+	}
+
+	if last, err := atr.LastActionTokenIssuedAt(ctx, u.UserID, biz.PurposeResetPassword); err != nil || !last.IsZero() {
+		t.Fatalf("expected no issued token, got %v %v", last, err)
+	}
+	// 一次性 token 只能使用一次, 且只能用于签发时的用途
+	for _, jti := range []string{"first", "second", "expired"} {
+		expiresAt := time.Now().Add(time.Hour)
+		if jti == "expired" {
+			expiresAt = time.Now().Add(-time.Minute)
+		}
+		if err := atr.CreateActionToken(ctx, &biz.ActionToken{Jti: jti, UserID: u.UserID, Purpose: biz.PurposeResetPassword, ExpiresAt: expiresAt}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if ok, _ := atr.UseActionToken(ctx, "first", biz.PurposeVerifyEmail); ok {
+		t.Fatal("expected token to be rejected for another purpose")
+	}
+	if ok, _ := atr.UseActionToken(ctx, "expired", biz.PurposeResetPassword); ok {
+		t.Fatal("expected expired token to be rejected")
+	}
+	if ok, err := atr.UseActionToken(ctx, "first", biz.PurposeResetPassword); !ok || err != nil {
+		t.Fatalf("use: %v %v", ok, err)
+	}
+	if ok, _ := atr.UseActionToken(ctx, "first", biz.PurposeResetPassword); ok {
+		t.Fatal("expected used token to be rejected")
+	}
+	if err := atr.InvalidateActionTokens(ctx, u.UserID, biz.PurposeResetPassword); err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := atr.UseActionToken(ctx, "second", biz.PurposeResetPassword); ok {
+		t.Fatal("expected invalidated token to be rejected")
+	}
+	// 失效的 token 仍计入最近签发时间
+	if last, err := atr.LastActionTokenIssuedAt(ctx, u.UserID, biz.PurposeResetPassword); err != nil || time.Since(last) > time.Minute {
+		t.Fatalf("expected recent issue time, got %v %v", last, err)
+	}
+	if last, err := atr.LastActionTokenIssuedAt(ctx, u.UserID, biz.PurposeVerifyEmail); err != nil || !last.IsZero() {
+		t.Fatalf("expected no verification token, got %v %v", last, err)
+	}
+}
+
+// 校验 access token 只需一次查询
+func TestGetTokenState(t *testing.T) {
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Dsn: "file:" + t.Name() + "?mode=memory&cache=shared", AutoMigrate: true}}
+	db, err := NewDB(c, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &Data{db: db}
+	tr := NewTokenRepo(d, log.DefaultLogger)
+	sr := NewSessionRepo(d, log.DefaultLogger)
+	ur := NewUserRepo(d, log.DefaultLogger)
+	ctx := context.Background()
+	u, other := &biz.User{Email: "a@b.c", Username: "a"}, &biz.User{Email: "b@b.c", Username: "b"}
+	for _, v := range []*biz.User{u, other} {
+		if err := ur.CreateUser(ctx, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	now := time.Now()
+	s := &biz.Session{UserID: u.UserID, LastSeenAt: now, ExpiresAt: now.Add(time.Hour)}
+	if err := sr.CreateSession(ctx, s); err != nil {
+		t.Fatal(err)
+	}
+	if err := tr.IncrTokenVersion(ctx, u.UserID); err != nil {
+		t.Fatal(err)
+	}
+	if err := tr.RevokeAccessToken(ctx, "revoked", now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	queries := 0
+	count := func(*gorm.DB) { queries++ }
+	if err := db.Callback().Query().After("gorm:query").Register("test:count_query", count); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Callback().Row().After("gorm:row").Register("test:count_row", count); err != nil {
+		t.Fatal(err)
+	}
+	st, err := tr.GetTokenState(ctx, u.UserID, "jti", s.ID)
+	if err != nil || st.Version != 1 || st.Revoked || st.Session == nil || st.Session.ID != s.ID || !st.Session.Active(now) {
+		t.Fatalf("unexpected state: %+v %v", st, err)
+	}
+	if queries != 1 {
+		t.Fatalf("expected 1 query, got %d", queries)
+	}
+	if st, err := tr.GetTokenState(ctx, u.UserID, "revoked", 0); err != nil || !st.Revoked || st.Session != nil {
+		t.Fatalf("expected revoked token without session, got %+v %v", st, err)
+	}
+	// 会话不属于该用户
+	if st, err := tr.GetTokenState(ctx, other.UserID, "jti", s.ID); err != nil || st.Session != nil {
+		t.Fatalf("expected other user's session to be ignored, got %+v %v", st, err)
+	}
+	if err := sr.RevokeUserSessions(ctx, u.UserID, 0); err != nil {
+		t.Fatal(err)
+	}
+	if st, err := tr.GetTokenState(ctx, u.UserID, "jti", s.ID); err != nil || st.Session == nil || st.Session.Active(now) {
+		t.Fatalf("expected revoked session, got %+v %v", st, err)
+	}
+	if err := db.Delete(&User{}, u.UserID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tr.GetTokenState(ctx, u.UserID, "jti", s.ID); !kerrors.IsNotFound(err) {
+		t.Fatalf("expected deleted user to be not found, got %v", err)
+	}
+}
@@ -3,34 +3,47 @@ package data
 import (
 	"context"
 	"demo/internal/biz"
+	"demo/internal/pkg/middleware/auth"
+	"fmt"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
+	"gorm.io/plugin/soft_delete"
 )
 
 // 用户表
 type User struct {
-	ID         int    `gorm:"primarykey;auto_increment" json:"id"`
-	CreatedAt  int    `gorm:"type:int(11);not null;default:0;comment:创建时间" json:"created_at"`
-	UpdatedAt  int    `gorm:"type:int(11);not null;default:0;comment:更新时间" json:"updated_at"`
-	DeletedAt  int    `gorm:"type:int(11);not null;default:0;comment:删除时间" json:"deleted_at"`
-	Email      string `gorm:"type:varchar(64);not null;comment:邮箱" json:"email"`
-	Username   string `gorm:"type:varchar(64);not null;comment:用户名" json:"username"`
-	Bio        string `gorm:"type:varchar(128);not null;comment:简介" json:"bio"`
-	Image      string `gorm:"type:varchar(128);not null;comment:图片" json:"image"`
-	PasswdHash string `gorm:"type:varchar(255);not null;comment:密码" json:"passwdhash"`
+	ID         int                   `gorm:"primaryKey;autoIncrement" json:"id"`
+	CreatedAt  int                   `gorm:"not null;comment:创建时间" json:"created_at"`
+	UpdatedAt  int                   `gorm:"not null;comment:更新时间" json:"updated_at"`
+	DeletedAt  soft_delete.DeletedAt `gorm:"not null;default:0;uniqueIndex:idx_users_email,priority:2;comment:删除时间" json:"deleted_at"`
+	Email      string                `gorm:"type:varchar(64);not null;uniqueIndex:idx_users_email,priority:1;comment:邮箱" json:"email"`
+	Username   string                `gorm:"type:varchar(64);not null;comment:用户名" json:"username"`
+	Bio        string                `gorm:"type:varchar(128);not null;comment:简介" json:"bio"`
+	Image      string                `gorm:"type:varchar(128);not null;comment:图片" json:"image"`
+	PasswdHash string                `gorm:"type:varchar(255);not null;comment:密码" json:"passwdhash"`
+	// token 版本, 退出所有设备时递增
+	TokenVersion int    `gorm:"not null;default:0;comment:token版本" json:"token_version"`
+	Role         string `gorm:"type:varchar(16);not null;default:user;index;comment:角色" json:"role"`
+	// 邮箱验证时间, 0 为未验证
+	EmailVerifiedAt int64 `gorm:"not null;default:0;comment:邮箱验证时间" json:"email_verified_at"`
+	// TOTP 密钥, 确认绑定前 TotpEnabledAt 为 0
+	TotpSecret    string `gorm:"type:varchar(64);not null;default:'';comment:TOTP密钥" json:"-"`
+	TotpEnabledAt int64  `gorm:"not null;default:0;comment:TOTP启用时间" json:"totp_enabled_at"`
+	// 最近一次使用的 TOTP 时间窗口, 防止验证码重放
+	TotpLastStep int64 `gorm:"not null;default:0;comment:TOTP最近使用的时间窗口" json:"-"`
 }
 
 // 关注表
 type Follow struct {
-	ID        int `gorm:"type:int(11);primarykey;auto_increment"`
-	CreatedAt int `gorm:"type:int(11);not null;default:0;comment:创建时间" json:"created_at"`
-	UpdatedAt int `gorm:"type:int(11);not null;default:0;comment:更新时间" json:"updated_at"`
-	DeletedAt int `gorm:"type:int(11);not null;default:0;comment:删除时间" json:"deleted_at"`
-	FollowID  int `gorm:"type:int(11);not null;comment:关注人ID" json:"follow_id"`
-	UserID    int `gorm:"type:int(11);not null;comment:用户ID" json:"user_id"`
+	ID        int                   `gorm:"primaryKey;autoIncrement"`
+	CreatedAt int                   `gorm:"not null;comment:创建时间" json:"created_at"`
+	UpdatedAt int                   `gorm:"not null;comment:更新时间" json:"updated_at"`
+	DeletedAt soft_delete.DeletedAt `gorm:"not null;default:0;comment:删除时间" json:"deleted_at"`
+	FollowID  int                   `gorm:"not null;comment:关注人ID" json:"follow_id"`
+	UserID    int                   `gorm:"not null;comment:用户ID" json:"user_id"`
 }
 
 type userRepo struct {
@@ -57,15 +70,16 @@ func (r *userRepo) CreateUser(ctx context.Context, u *biz.User) error {
 		Bio:        u.Bio,
 		Image:      u.Image,
 		PasswdHash: u.PasswdHash,
+		Role:       u.Role,
 	}
-	rv := r.data.db.Create(&ud)
+	rv := r.data.DB(ctx).Create(&ud)
 	u.UserID = int(ud.ID)
 	return rv.Error
 }
 
 func (r *userRepo) VerifyUserExistByEmail(ctx context.Context, email string) bool {
 	var count int64
-	r.data.db.Model(&User{}).Where("email=?", email).Count(&count)
+	r.data.DB(ctx).Model(&User{}).Where("email=?", email).Count(&count)
 	if count > 0 {
 		return true
 	}
@@ -73,7 +87,7 @@ func (r *userRepo) VerifyUserExistByEmail(ctx context.Context, email string) boo
 }
 func (r *userRepo) GetUserByEmail(ctx context.Context, email string) (*biz.User, error) {
 	u := new(User)
-	res := r.data.db.Where("email=?", email).First(&u)
+	res := r.data.DB(ctx).Where("email=?", email).First(&u)
 	if errors.Is(res.Error, gorm.ErrRecordNotFound) {
 		return nil, errors.NotFound("user", "not found by email")
 	}
@@ -81,18 +95,21 @@ func (r *userRepo) GetUserByEmail(ctx context.Context, email string) (*biz.User,
 		return nil, res.Error
 	}
 	return &biz.User{
-		UserID:     int(u.ID),
-		Email:      u.Email,
-		Username:   u.Username,
-		Bio:        u.Bio,
-		Image:      u.Image,
-		PasswdHash: u.PasswdHash,
+		UserID:        int(u.ID),
+		Email:         u.Email,
+		Username:      u.Username,
+		Bio:           u.Bio,
+		Image:         u.Image,
+		PasswdHash:    u.PasswdHash,
+		Role:          u.Role,
+		EmailVerified: u.EmailVerifiedAt > 0,
+		TOTPEnabled:   u.TotpEnabledAt > 0,
 	}, nil
 }
 
 func (r *userRepo) GetUserByUserID(ctx context.Context, userId int) (*biz.User, error) {
 	u := new(User)
-	res := r.data.db.Where("id=?", userId).First(&u)
+	res := r.data.DB(ctx).Where("id=?", userId).First(&u)
 	if errors.Is(res.Error, gorm.ErrRecordNotFound) {
 		return nil, errors.NotFound("user", "not found by user id")
 	}
@@ -100,18 +117,21 @@ func (r *userRepo) GetUserByUserID(ctx context.Context, userId int) (*biz.User,
 		return nil, res.Error
 	}
 	return &biz.User{
-		UserID:     int(u.ID),
-		Email:      u.Email,
-		Username:   u.Username,
-		Bio:        u.Bio,
-		Image:      u.Image,
-		PasswdHash: u.PasswdHash,
+		UserID:        int(u.ID),
+		Email:         u.Email,
+		Username:      u.Username,
+		Bio:           u.Bio,
+		Image:         u.Image,
+		PasswdHash:    u.PasswdHash,
+		Role:          u.Role,
+		EmailVerified: u.EmailVerifiedAt > 0,
+		TOTPEnabled:   u.TotpEnabledAt > 0,
 	}, nil
 }
 
 func (r *userRepo) GetUserByUserName(ctx context.Context, username string) (*biz.User, error) {
 	u := new(User)
-	res := r.data.db.Where("username=?", username).First(&u)
+	res := r.data.DB(ctx).Where("username=?", username).First(&u)
 	if errors.Is(res.Error, gorm.ErrRecordNotFound) {
 		return nil, errors.NotFound("user", "not found by username")
 	}
@@ -119,15 +139,44 @@ func (r *userRepo) GetUserByUserName(ctx context.Context, username string) (*biz
 		return nil, res.Error
 	}
 	return &biz.User{
-		UserID:     int(u.ID),
-		Email:      u.Email,
-		Username:   u.Username,
-		Bio:        u.Bio,
-		Image:      u.Image,
-		PasswdHash: u.PasswdHash,
+		UserID:        int(u.ID),
+		Email:         u.Email,
+		Username:      u.Username,
+		Bio:           u.Bio,
+		Image:         u.Image,
+		PasswdHash:    u.PasswdHash,
+		Role:          u.Role,
+		EmailVerified: u.EmailVerifiedAt > 0,
+		TOTPEnabled:   u.TotpEnabledAt > 0,
 	}, nil
 }
 
+func (r *userRepo) GetUsersByUserIDs(ctx context.Context, ids []int) ([]*biz.User, error) {
+	rv := []*biz.User{}
+	if len(ids) == 0 {
+		return rv, nil
+	}
+	us := []User{}
+	res := r.data.DB(ctx).Where("id in ?", ids).Find(&us)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	for _, u := range us {
+		rv = append(rv, &biz.User{
+			UserID:        int(u.ID),
+			Email:         u.Email,
+			Username:      u.Username,
+			Bio:           u.Bio,
+			Image:         u.Image,
+			PasswdHash:    u.PasswdHash,
+			Role:          u.Role,
+			EmailVerified: u.EmailVerifiedAt > 0,
+			TOTPEnabled:   u.TotpEnabledAt > 0,
+		})
+	}
+	return rv, nil
+}
+
 func (r *userRepo) UpdateUser(ctx context.Context, userId int, bu *biz.User) (*biz.User, error) {
 	u := &User{
 		Username:   bu.Username,
@@ -136,18 +185,60 @@ func (r *userRepo) UpdateUser(ctx context.Context, userId int, bu *biz.User) (*b
 		Image:      bu.Image,
 		Bio:        bu.Bio,
 	}
-	res := r.data.db.Model(&User{}).Where("id=?", userId).Updates(u)
-	if errors.Is(res.Error, gorm.ErrRecordNotFound) {
-		return nil, errors.NotFound("user", "not found by email")
+	// 修改邮箱后需重新验证
+	if bu.Email != "" {
+		if err := r.data.DB(ctx).Model(&User{}).Where("id=? and email<>?", userId, bu.Email).Update("email_verified_at", 0).Error; err != nil {
+			return nil, err
+		}
 	}
-	return &biz.User{
-		UserID:     userId,
-		Email:      u.Email,
-		Username:   u.Username,
-		Bio:        u.Bio,
-		Image:      u.Image,
-		PasswdHash: u.PasswdHash,
-	}, nil
+	if err := r.data.DB(ctx).Model(&User{}).Where("id=?", userId).Updates(u).Error; err != nil {
+		return nil, err
+	}
+	// 返回更新后的完整用户信息, 包括未修改的字段与角色
+	return r.GetUserByUserID(ctx, userId)
+}
+
+// 修改角色并使该用户已签发的 token 失效, 新角色在重新登录或刷新 token 后生效
+func (r *userRepo) SetUserRole(ctx context.Context, userId int, role string) error {
+	res := r.data.DB(ctx).Model(&User{}).Where("id=?", userId).Updates(map[string]interface{}{
+		"role":          role,
+		"token_version": gorm.Expr("token_version + 1"),
+	})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return errors.NotFound("user", "not found by user id")
+	}
+	return nil
+}
+
+func (r *userRepo) MarkEmailVerified(ctx context.Context, userId int) error {
+	return r.data.DB(ctx).Model(&User{}).Where("id=? and email_verified_at=0", userId).Update("email_verified_at", time.Now().Unix()).Error
+}
+
+// BootstrapAdmin 将指定邮箱的用户设为管理员, 仅在尚无管理员时可用
+func BootstrapAdmin(ctx context.Context, db *gorm.DB, email string) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&User{}).Where("role=?", auth.RoleAdmin).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return fmt.Errorf("an admin already exists, use the SetUserRole API instead")
+		}
+		res := tx.Model(&User{}).Where("email=?", email).Updates(map[string]interface{}{
+			"role":          auth.RoleAdmin,
+			"token_version": gorm.Expr("token_version + 1"),
+		})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return fmt.Errorf("user not found by email: %s", email)
+		}
+		return nil
+	})
 }
 
 func NewProfileRepo(data *Data, logger log.Logger) biz.ProfileRepo {
@@ -160,7 +251,7 @@ func NewProfileRepo(data *Data, logger log.Logger) biz.ProfileRepo {
 // 获取关注用户
 func (p *profileRepo) GetFollowByUserID(ctx context.Context, userId int) (*biz.Follow, error) {
 	f := new(Follow)
-	res := p.data.db.Where("follow_id=? and user_id=?", userId).First(&f)
+	res := p.data.DB(ctx).Where("follow_id=? and user_id=?", userId).First(&f)
 	if errors.Is(res.Error, gorm.ErrRecordNotFound) {
 		return nil, errors.NotFound("follow", "not found by username")
 	}
@@ -170,11 +261,11 @@ func (p *profileRepo) GetFollowByUserID(ctx context.Context, userId int) (*biz.F
 // 关注用户
 func (p *profileRepo) FollowUser(ctx context.Context, myUserId, userId int) (bool, error) {
 	f := new(Follow)
-	res := p.data.db.Model(&Follow{}).Where("follow_id=? and user_id=?", userId, myUserId).First(&f)
+	res := p.data.DB(ctx).Model(&Follow{}).Where("follow_id=? and user_id=?", userId, myUserId).First(&f)
 	if errors.Is(res.Error, gorm.ErrRecordNotFound) {
 		f.FollowID = userId
 		f.UserID = myUserId
-		tx := p.data.db.Create(&f)
+		tx := p.data.DB(ctx).Create(&f)
 		if tx.Error != nil {
 			return false, tx.Error
 		}
@@ -184,9 +275,26 @@ func (p *profileRepo) FollowUser(ctx context.Context, myUserId, userId int) (boo
 
 // 取消关注
 func (p *profileRepo) UnfollowUser(ctx context.Context, myUserId, userId int) (bool, error) {
-	res := p.data.db.Model(&Follow{}).Where("follow_id=? and user_id=?", userId, myUserId).Update("deleted_at", time.Now())
-	if errors.Is(res.Error, gorm.ErrRecordNotFound) {
-		return false, nil
+	res := p.data.DB(ctx).Where("follow_id=? and user_id=?", userId, myUserId).Delete(&Follow{})
+	if res.Error != nil {
+		return false, res.Error
 	}
-	return true, nil
+	return res.RowsAffected > 0, nil
+}
+
+// 批量查询关注状态
+func (p *profileRepo) GetFollowing(ctx context.Context, myUserId int, userIds []int) (map[int]bool, error) {
+	rv := make(map[int]bool, len(userIds))
+	if len(userIds) == 0 {
+		return rv, nil
+	}
+	fs := []Follow{}
+	res := p.data.DB(ctx).Where("user_id=? and follow_id in ?", myUserId, userIds).Find(&fs)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	for _, f := range fs {
+		rv[f.FollowID] = true
+	}
+	return rv, nil
 }
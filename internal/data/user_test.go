@@ -0,0 +1,69 @@
+package data
+
+import (
+	"context"
+	"demo/internal/biz"
+	"demo/internal/conf"
+	"demo/internal/pkg/middleware/auth"
+	"testing"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestUserRepo(t *testing.T) {
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Dsn: "file:" + t.Name() + "?mode=memory&cache=shared", AutoMigrate: true}}
+	db, err := NewDB(c, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &Data{db: db}
+	ur := NewUserRepo(d, log.DefaultLogger)
+	tr := NewTokenRepo(d, log.DefaultLogger)
+	ctx := context.Background()
+	u, deleted := &biz.User{Email: "a@b.c", Username: "a", Role: auth.RoleUser}, &biz.User{Email: "deleted@b.c", Username: "deleted"}
+	for _, v := range []*biz.User{u, deleted} {
+		if err := ur.CreateUser(ctx, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 邮箱唯一, 已删除用户的邮箱可以重新注册
+	if err := ur.CreateUser(ctx, &biz.User{Email: "a@b.c", Username: "other"}); err == nil {
+		t.Fatal("expected duplicate email to be rejected")
+	}
+	if err := d.db.Delete(&User{}, deleted.UserID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := ur.CreateUser(ctx, &biz.User{Email: "deleted@b.c", Username: "deleted"}); err != nil {
+		t.Fatalf("expected email of deleted user to be reusable, got %v", err)
+	}
+
+	// 修改角色时 token 版本加一
+	if err := ur.SetUserRole(ctx, u.UserID, auth.RoleAdmin); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := tr.GetTokenVersion(ctx, u.UserID); err != nil || v != 1 {
+		t.Fatalf("expected token version 1, got %d %v", v, err)
+	}
+	if err := ur.SetUserRole(ctx, 1000, auth.RoleAdmin); !kerrors.IsNotFound(err) {
+		t.Fatalf("expected unknown user to be rejected, got %v", err)
+	}
+
+	// 只更新非零值字段, 邮箱修改后需重新验证
+	if err := ur.MarkEmailVerified(ctx, u.UserID); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ur.UpdateUser(ctx, u.UserID, &biz.User{Email: "a@b.c", Bio: "bio"})
+	if err != nil || !got.EmailVerified || got.Bio != "bio" || got.Username != "a" || got.Role != auth.RoleAdmin {
+		t.Fatalf("unexpected user after update: %+v %v", got, err)
+	}
+	got, err = ur.UpdateUser(ctx, u.UserID, &biz.User{Email: "c@b.c"})
+	if err != nil || got.EmailVerified || got.Email != "c@b.c" || got.Bio != "bio" {
+		t.Fatalf("expected changed email to be unverified, got %+v %v", got, err)
+	}
+	us, err := ur.GetUsersByUserIDs(ctx, []int{u.UserID, deleted.UserID})
+	if err != nil || len(us) != 1 || us[0].UserID != u.UserID {
+		t.Fatalf("expected deleted user to be skipped, got %+v %v", us, err)
+	}
+}
@@ -2,18 +2,33 @@ package errors
 
 import (
 	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/errors"
 )
 
+const MOVED_PERMANENTLY = 301
+const BAD_REQUEST = 400
 const UNAUTHORIZED = 401
 const FORBIDDEN = 403
 const NOT_FOUND = 404
+const CONFLICT = 409
 const PARAM_INVALID = 422
+const TOO_MANY_REQUESTS = 429
+const SERVICE_UNAVAILABLE = 503
+
+// 参数校验失败, 各字段的错误信息放在 metadata 中
+const REASON_VALIDATION = "VALIDATION"
 
 type HTTPError struct {
 	Errors map[string][]string `json:"errors"`
 	Code   int                 `json:"-"`
+	// 错误附带的元数据, 如 retry_after
+	Metadata map[string]string `json:"-"`
 }
 
 func NewHttpError(code int, field string, detail string) *HTTPError {
@@ -37,7 +52,86 @@ func FromError(err error) *HTTPError {
 		if se.Reason == "CODEC" {
 			return NewHttpError(int(se.Code), "message", se.Message)
 		}
-		return NewHttpError(int(se.Code), se.Reason, se.Message)
+		if se.Reason == REASON_VALIDATION && len(se.Metadata) > 0 {
+			he := &HTTPError{Code: int(se.Code), Errors: make(map[string][]string, len(se.Metadata))}
+			for field, detail := range se.Metadata {
+				he.Errors[field] = []string{detail}
+			}
+			return he
+		}
+		he := NewHttpError(int(se.Code), se.Reason, se.Message)
+		he.Metadata = se.Metadata
+		return he
 	}
 	return NewHttpError(500, "internal", "error")
 }
+
+// MovedPermanently 资源已迁移, 新地址由调用方通过 Location 响应头返回
+func MovedPermanently(field string, detail string) error {
+	return errors.New(MOVED_PERMANENTLY, field, detail)
+}
+
+// BadRequest 请求无效, 如 token 错误或已过期
+func BadRequest(field string, detail string) error {
+	return errors.New(BAD_REQUEST, field, detail)
+}
+
+// Unauthorized 未登录或登录信息无效
+func Unauthorized(field string, detail string) error {
+	return errors.New(UNAUTHORIZED, field, detail)
+}
+
+// Forbidden 无权限操作
+func Forbidden(field string, detail string) error {
+	return errors.New(FORBIDDEN, field, detail)
+}
+
+// NotFound 资源不存在
+func NotFound(field string, detail string) error {
+	return errors.New(NOT_FOUND, field, detail)
+}
+
+// IsNotFound 是否为资源不存在的错误
+func IsNotFound(err error) bool {
+	return errors.IsNotFound(err)
+}
+
+// Conflict 与已有数据冲突
+func Conflict(field string, detail string) error {
+	return errors.New(CONFLICT, field, detail)
+}
+
+// ParamInvalid 参数不合法
+func ParamInvalid(field string, detail string) error {
+	return errors.New(PARAM_INVALID, field, detail)
+}
+
+// TooManyRequests 请求过于频繁, retryAfter 通过 Retry-After 响应头返回
+func TooManyRequests(field string, detail string, retryAfter time.Duration) error {
+	seconds := int64(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	return errors.New(TOO_MANY_REQUESTS, field, detail).WithMetadata(map[string]string{
+		"retry_after": strconv.FormatInt(seconds, 10),
+	})
+}
+
+// Validation 参数校验失败, fields 为字段名 => 错误信息
+func Validation(fields map[string]string) error {
+	names := make([]string, 0, len(fields))
+	for field := range fields {
+		names = append(names, field)
+	}
+	sort.Strings(names)
+	msgs := make([]string, 0, len(names))
+	for _, field := range names {
+		msgs = append(msgs, field+": "+fields[field])
+	}
+	return errors.New(PARAM_INVALID, REASON_VALIDATION, strings.Join(msgs, "; ")).WithMetadata(fields)
+}
+
+// ServiceUnavailable 依赖的外部服务不可用
+func ServiceUnavailable(field string, detail string) error {
+	return errors.New(SERVICE_UNAVAILABLE, field, detail)
+}
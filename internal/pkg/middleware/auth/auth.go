@@ -2,7 +2,8 @@ package auth
 
 import (
 	"context"
-	"fmt"
+	"crypto/rand"
+	"encoding/hex"
 	"strings"
 	"time"
 
@@ -16,56 +17,178 @@ type LoginUser struct {
 	Email    string
 	Username string
 	UserID   int
+	Role     string `json:"role,omitempty"`
+	// 登录会话 ID, 退出该会话后 token 失效
+	SessionID int `json:"sid,omitempty"`
+	// 当前请求使用的 token 及其 ID、过期时间, 不写入 claims
+	Token          string    `json:"-"`
+	TokenID        string    `json:"-"`
+	TokenExpiresAt time.Time `json:"-"`
+	// 使用个人访问令牌登录时为 true, Scopes 为令牌的授权范围
+	PersonalToken bool     `json:"-"`
+	Scopes        []string `json:"-"`
 }
 type CustomClaims struct {
 	LoginUser
+	// 用户 token 版本, 退出所有设备后递增, 旧版本 token 失效
+	TokenVersion int `json:"tv,omitempty"`
 	jwt.StandardClaims
 }
 
+type loginUserKey struct{}
+
+// NewContext 将登录用户写入 context
+func NewContext(ctx context.Context, u LoginUser) context.Context {
+	return context.WithValue(ctx, loginUserKey{}, u)
+}
+
+// FromContext 获取登录用户, 未登录时返回 false
+func FromContext(ctx context.Context) (LoginUser, bool) {
+	u, ok := ctx.Value(loginUserKey{}).(LoginUser)
+	return u, ok
+}
+
+// IssuedToken 签发的 access token
+type IssuedToken struct {
+	Token     string
+	ID        string
+	ExpiresAt time.Time
+}
+
+// RevocationChecker 校验 token 是否已被吊销, 每个携带 JWT 的请求都会调用一次,
+// 实现应控制单次开销: TokenUsecase 以一次数据库查询完成校验
+type RevocationChecker interface {
+	IsRevoked(ctx context.Context, claims *CustomClaims) (bool, error)
+}
+
 // GenerateToken create a token string
-func GenerateToken(secret []byte, email, username string, userId int) (string, error) {
+func GenerateToken(ks *KeySet, u LoginUser, tokenVersion int, ttl time.Duration) (*IssuedToken, error) {
+	now := time.Now()
 	claims := CustomClaims{
-		LoginUser{
-			email,
-			username,
-			userId,
+		LoginUser: LoginUser{
+			Email:     u.Email,
+			Username:  u.Username,
+			UserID:    u.UserID,
+			Role:      u.Role,
+			SessionID: u.SessionID,
 		},
-		jwt.StandardClaims{
-			ExpiresAt: time.Now().Add(10 * time.Hour).Unix(),
+		TokenVersion: tokenVersion,
+		StandardClaims: jwt.StandardClaims{
+			Id:        NewTokenID(),
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(ttl).Unix(),
 		},
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString(secret)
-	return tokenString, err
+	tokenString, err := ks.Sign(claims)
+	if err != nil {
+		return nil, err
+	}
+	return &IssuedToken{Token: tokenString, ID: claims.Id, ExpiresAt: time.Unix(claims.StandardClaims.ExpiresAt, 0)}, nil
+}
+
+// NewTokenID 生成随机 token ID
+func NewTokenID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
 }
 
 // ParseToken parse custom info
-func ParseTokenByCtx(ctx context.Context, secret []byte) (context.Context, error) {
-	if tr, ok := transport.FromServerContext(ctx); ok {
-		auths := strings.SplitN(tr.RequestHeader().Get("Authorization"), " ", 2)
-		if len(auths) != 2 || !strings.EqualFold(auths[0], "Token") {
-			return ctx, errors.New(400, "header", "lost jwt token")
+func ParseTokenByCtx(ctx context.Context, ks *KeySet) (context.Context, error) {
+	ctx, _, err := parseTokenByCtx(ctx, ks)
+	return ctx, err
+}
+
+// 从 Authorization: Token xxx 中取出 token
+func tokenFromCtx(ctx context.Context) (string, error) {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return "", errors.New(500, "header", "error")
+	}
+	auths := strings.SplitN(tr.RequestHeader().Get("Authorization"), " ", 2)
+	if len(auths) != 2 || !strings.EqualFold(auths[0], "Token") {
+		return "", errors.Unauthorized("header", "lost jwt token")
+	}
+	return auths[1], nil
+}
+
+func parseTokenByCtx(ctx context.Context, ks *KeySet) (context.Context, *CustomClaims, error) {
+	tokenString, err := tokenFromCtx(ctx)
+	if err != nil {
+		return ctx, nil, err
+	}
+	return parseToken(ctx, ks, tokenString)
+}
+
+func parseToken(ctx context.Context, ks *KeySet, tokenString string) (context.Context, *CustomClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &CustomClaims{}, ks.Keyfunc)
+	if err != nil {
+		return ctx, nil, errors.Unauthorized("token", err.Error())
+	}
+	if claims, ok := token.Claims.(*CustomClaims); ok && token.Valid && claims.Audience == "" {
+		// 带 aud 的是邮箱验证等一次性 token, 不能用于登录
+		claims.Token = tokenString
+		claims.TokenID = claims.Id
+		claims.TokenExpiresAt = time.Unix(claims.ExpiresAt, 0)
+		ctx = NewContext(ctx, claims.LoginUser)
+		return ctx, claims, nil
+	}
+	return ctx, nil, errors.Unauthorized("token", "invalid token")
+}
+
+// 解析并校验 token, checker 不为空时拒绝已吊销的 token; pats 不为空时同时接受个人访问令牌
+func authenticate(ctx context.Context, ks *KeySet, checker RevocationChecker, pats PersonalTokenAuthenticator) (context.Context, error) {
+	tokenString, err := tokenFromCtx(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	if pats != nil && strings.HasPrefix(tokenString, PersonalTokenPrefix) {
+		u, err := pats.AuthenticatePersonalToken(ctx, tokenString)
+		if err != nil {
+			return ctx, err
+		}
+		u.PersonalToken = true
+		return NewContext(ctx, u), nil
+	}
+	ctx, claims, err := parseToken(ctx, ks, tokenString)
+	if err != nil {
+		return ctx, err
+	}
+	if checker != nil {
+		revoked, err := checker.IsRevoked(ctx, claims)
+		if err != nil {
+			return ctx, err
+		}
+		if revoked {
+			return ctx, errors.Unauthorized("token", "token has been revoked")
 		}
-		token, err := jwt.ParseWithClaims(auths[1], &CustomClaims{}, func(token *jwt.Token) (interface{}, error) {
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
+	}
+	return ctx, nil
+}
+
+// JWTAuth is used for middleware, checker 不为空时拒绝已吊销的 token, pats 不为空时同时接受个人访问令牌
+func JWTAuth(ks *KeySet, checker RevocationChecker, pats PersonalTokenAuthenticator) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			ctx, err = authenticate(ctx, ks, checker, pats)
+			if err != nil {
+				return nil, err
 			}
-			return secret, nil
-		})
-		if claims, ok := token.Claims.(*CustomClaims); ok && token.Valid {
-			ctx = context.WithValue(ctx, "loginUser", claims.LoginUser)
-			return ctx, nil
+			return handler(ctx, req)
 		}
-		return ctx, err
 	}
-	return ctx, errors.New(500, "header", "error")
 }
 
-// JWTAuth is used for middleware
-func JWTAuth(secret []byte) middleware.Middleware {
+// OptionalJWTAuth 允许匿名访问, 未携带 Authorization 时直接放行, 携带时与 JWTAuth 一致校验
+func OptionalJWTAuth(ks *KeySet, checker RevocationChecker, pats PersonalTokenAuthenticator) middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
-			ctx, err = ParseTokenByCtx(ctx, secret)
+			if tr, ok := transport.FromServerContext(ctx); ok && tr.RequestHeader().Get("Authorization") == "" {
+				return handler(ctx, req)
+			}
+			ctx, err = authenticate(ctx, ks, checker, pats)
 			if err != nil {
 				return nil, err
 			}
@@ -1,8 +1,76 @@
 package auth
 
 import (
+	"context"
+	"net/http"
 	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
 )
 
+type headerCarrier http.Header
+
+func (hc headerCarrier) Get(key string) string { return http.Header(hc).Get(key) }
+
+func (hc headerCarrier) Set(key string, value string) { http.Header(hc).Set(key, value) }
+
+func (hc headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(hc))
+	for k := range hc {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+type testTransport struct {
+	header    headerCarrier
+	operation string
+}
+
+func (tr *testTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (tr *testTransport) Endpoint() string                { return "" }
+func (tr *testTransport) Operation() string               { return tr.operation }
+func (tr *testTransport) RequestHeader() transport.Header { return tr.header }
+func (tr *testTransport) ReplyHeader() transport.Header   { return headerCarrier{} }
+
+type revokedIDs map[string]bool
+
+func (r revokedIDs) IsRevoked(ctx context.Context, claims *CustomClaims) (bool, error) {
+	return r[claims.Id], nil
+}
+
 func TestGenerateToken(t *testing.T) {
+	key, _ := NewHMACKey("", "HS256", []byte("secret"))
+	ks, err := NewKeySet(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tk, err := GenerateToken(ks, LoginUser{Email: "a@b.c", Username: "a", UserID: 1, Role: RoleModerator}, 2, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tk.ID == "" || time.Until(tk.ExpiresAt) > time.Minute {
+		t.Fatalf("unexpected token: %+v", tk)
+	}
+	header := headerCarrier{}
+	header.Set("Authorization", "Token "+tk.Token)
+	ctx := transport.NewServerContext(context.Background(), &testTransport{header: header})
+
+	var got LoginUser
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		got, _ = FromContext(ctx)
+		return nil, nil
+	}
+	if _, err := JWTAuth(ks, revokedIDs{}, nil)(handler)(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got.UserID != 1 || got.Role != RoleModerator || got.TokenID != tk.ID || got.Token != tk.Token {
+		t.Fatalf("unexpected login user: %+v", got)
+	}
+	_, err = JWTAuth(ks, revokedIDs{tk.ID: true}, nil)(handler)(ctx, nil)
+	if errors.Code(err) != 401 {
+		t.Fatalf("expected 401 for revoked token, got %v", err)
+	}
 }
@@ -0,0 +1,271 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"demo/internal/conf"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// Key 签名密钥, 私钥为空时仅用于校验
+type Key struct {
+	ID        string
+	Method    jwt.SigningMethod
+	NotBefore time.Time
+	NotAfter  time.Time
+
+	signKey   interface{}
+	verifyKey interface{}
+}
+
+// NewHMACKey HS 系列算法的共享密钥
+func NewHMACKey(kid, alg string, secret []byte) (*Key, error) {
+	m, ok := jwt.GetSigningMethod(alg).(*jwt.SigningMethodHMAC)
+	if !ok {
+		return nil, fmt.Errorf("key %q: %s is not a HMAC algorithm", kid, alg)
+	}
+	return &Key{ID: kid, Method: m, signKey: secret, verifyKey: secret}, nil
+}
+
+// NewKey 非对称密钥, privatePEM 为空时仅用于校验
+func NewKey(kid, alg string, privatePEM, publicPEM []byte) (*Key, error) {
+	m := jwt.GetSigningMethod(alg)
+	if m == nil {
+		return nil, fmt.Errorf("key %q: unsupported algorithm %s", kid, alg)
+	}
+	if len(privatePEM) == 0 && len(publicPEM) == 0 {
+		return nil, fmt.Errorf("key %q: private or public key is required", kid)
+	}
+	k := &Key{ID: kid, Method: m}
+	var err error
+	switch m.(type) {
+	case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS:
+		if len(privatePEM) > 0 {
+			var priv *rsa.PrivateKey
+			if priv, err = jwt.ParseRSAPrivateKeyFromPEM(privatePEM); err == nil {
+				k.signKey, k.verifyKey = priv, &priv.PublicKey
+			}
+		} else {
+			k.verifyKey, err = jwt.ParseRSAPublicKeyFromPEM(publicPEM)
+		}
+	case *jwt.SigningMethodECDSA:
+		if len(privatePEM) > 0 {
+			var priv *ecdsa.PrivateKey
+			if priv, err = jwt.ParseECPrivateKeyFromPEM(privatePEM); err == nil {
+				k.signKey, k.verifyKey = priv, &priv.PublicKey
+			}
+		} else {
+			k.verifyKey, err = jwt.ParseECPublicKeyFromPEM(publicPEM)
+		}
+	case *jwt.SigningMethodEd25519:
+		if len(privatePEM) > 0 {
+			var priv crypto.PrivateKey
+			if priv, err = jwt.ParseEdPrivateKeyFromPEM(privatePEM); err == nil {
+				k.signKey, k.verifyKey = priv, priv.(ed25519.PrivateKey).Public()
+			}
+		} else {
+			k.verifyKey, err = jwt.ParseEdPublicKeyFromPEM(publicPEM)
+		}
+	default:
+		return nil, fmt.Errorf("key %q: %s is not an asymmetric algorithm", kid, alg)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("key %q: %w", kid, err)
+	}
+	return k, nil
+}
+
+// 密钥在 t 时刻是否可用于校验
+func (k *Key) validAt(t time.Time) bool {
+	return k.NotAfter.IsZero() || t.Before(k.NotAfter)
+}
+
+// KeySet 按 kid 管理的一组密钥, 支持密钥轮换
+type KeySet struct {
+	keys map[string]*Key
+	// 已生效的签名密钥按 NotBefore 排序
+	signers []*Key
+}
+
+// NewKeySet kid 为空的密钥用于校验不带 kid 的旧 token
+func NewKeySet(keys ...*Key) (*KeySet, error) {
+	ks := &KeySet{keys: make(map[string]*Key, len(keys))}
+	for _, k := range keys {
+		if _, ok := ks.keys[k.ID]; ok {
+			return nil, fmt.Errorf("duplicate key id %q", k.ID)
+		}
+		ks.keys[k.ID] = k
+		if k.signKey != nil {
+			ks.signers = append(ks.signers, k)
+		}
+	}
+	if len(ks.signers) == 0 {
+		return nil, fmt.Errorf("no signing key configured")
+	}
+	sort.SliceStable(ks.signers, func(i, j int) bool {
+		return ks.signers[i].NotBefore.Before(ks.signers[j].NotBefore)
+	})
+	return ks, nil
+}
+
+// NewKeySetFromConfig 从配置加载密钥, 未配置 keys 时使用 secret 签发 HS256 token
+func NewKeySetFromConfig(c *conf.JWT) (*KeySet, error) {
+	keys := []*Key{}
+	if c.Secret != "" {
+		k, err := NewHMACKey("", jwt.SigningMethodHS256.Alg(), []byte(c.Secret))
+		if err != nil {
+			return nil, err
+		}
+		// 配置了 keys 时仅用于校验旧 token
+		if len(c.Keys) > 0 {
+			k.signKey = nil
+		}
+		keys = append(keys, k)
+	}
+	for _, kc := range c.Keys {
+		if kc.Kid == "" {
+			return nil, fmt.Errorf("jwt key id is required")
+		}
+		var (
+			k   *Key
+			err error
+		)
+		if strings.HasPrefix(kc.Algorithm, "HS") {
+			k, err = NewHMACKey(kc.Kid, kc.Algorithm, []byte(kc.Secret))
+		} else {
+			var priv, pub []byte
+			if kc.PrivateKeyFile != "" {
+				if priv, err = os.ReadFile(kc.PrivateKeyFile); err != nil {
+					return nil, err
+				}
+			}
+			if kc.PublicKeyFile != "" {
+				if pub, err = os.ReadFile(kc.PublicKeyFile); err != nil {
+					return nil, err
+				}
+			}
+			k, err = NewKey(kc.Kid, kc.Algorithm, priv, pub)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if kc.NotBefore != nil {
+			k.NotBefore = kc.NotBefore.AsTime()
+		}
+		if kc.NotAfter != nil {
+			k.NotAfter = kc.NotAfter.AsTime()
+		}
+		keys = append(keys, k)
+	}
+	return NewKeySet(keys...)
+}
+
+// SigningKey 返回 t 时刻用于签发的密钥: 已生效且未过期的密钥中 NotBefore 最晚的一个
+func (ks *KeySet) SigningKey(t time.Time) (*Key, error) {
+	for i := len(ks.signers) - 1; i >= 0; i-- {
+		k := ks.signers[i]
+		if !t.Before(k.NotBefore) && k.validAt(t) {
+			return k, nil
+		}
+	}
+	return nil, fmt.Errorf("no active signing key")
+}
+
+// Sign 使用当前签名密钥签发 token, header 中带上 kid
+func (ks *KeySet) Sign(claims jwt.Claims) (string, error) {
+	k, err := ks.SigningKey(time.Now())
+	if err != nil {
+		return "", err
+	}
+	token := jwt.NewWithClaims(k.Method, claims)
+	if k.ID != "" {
+		token.Header["kid"] = k.ID
+	}
+	return token.SignedString(k.signKey)
+}
+
+// Keyfunc 按 kid 查找校验密钥, 算法必须与密钥一致
+func (ks *KeySet) Keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	k, ok := ks.keys[kid]
+	if !ok || !k.validAt(time.Now()) {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	if token.Method.Alg() != k.Method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+	return k.verifyKey, nil
+}
+
+// JWK 公钥, 格式见 RFC 7517
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// JWKS 返回当前可用于校验的公钥, 不包含共享密钥
+func (ks *KeySet) JWKS() []JWK {
+	now := time.Now()
+	rv := []JWK{}
+	for _, k := range ks.keys {
+		if !k.validAt(now) || k.ID == "" {
+			continue
+		}
+		jwk := JWK{Kid: k.ID, Use: "sig", Alg: k.Method.Alg()}
+		switch pub := k.verifyKey.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = b64(pub.N.Bytes())
+			jwk.E = b64(big.NewInt(int64(pub.E)).Bytes())
+		case *ecdsa.PublicKey:
+			size := (pub.Curve.Params().BitSize + 7) / 8
+			jwk.Kty = "EC"
+			jwk.Crv = pub.Curve.Params().Name
+			jwk.X = b64(pub.X.FillBytes(make([]byte, size)))
+			jwk.Y = b64(pub.Y.FillBytes(make([]byte, size)))
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = b64(pub)
+		default:
+			continue
+		}
+		rv = append(rv, jwk)
+	}
+	sort.Slice(rv, func(i, j int) bool {
+		return rv[i].Kid < rv[j].Kid
+	})
+	return rv
+}
+
+func b64(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// JWKSHandler 提供 /.well-known/jwks.json
+func (ks *KeySet) JWKSHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		_ = json.NewEncoder(w).Encode(map[string][]JWK{"keys": ks.JWKS()})
+	})
+}
@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+func privatePEM(t *testing.T, key interface{}) []byte {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func publicPEM(t *testing.T, key interface{}) []byte {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func TestKeySetRotation(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	_, edKey, _ := ed25519.GenerateKey(rand.Reader)
+
+	oldKey, err := NewKey("old", "RS256", privatePEM(t, rsaKey), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	curKey, err := NewKey("cur", "ES256", privatePEM(t, ecKey), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	curKey.NotBefore = time.Now().Add(-time.Hour)
+	nextKey, err := NewKey("next", "EdDSA", privatePEM(t, edKey), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nextKey.NotBefore = time.Now().Add(time.Hour)
+	ks, err := NewKeySet(oldKey, curKey, nextKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if k, _ := ks.SigningKey(time.Now()); k.ID != "cur" {
+		t.Fatalf("expected cur signing key, got %s", k.ID)
+	}
+	if k, _ := ks.SigningKey(time.Now().Add(2 * time.Hour)); k.ID != "next" {
+		t.Fatalf("expected next signing key, got %s", k.ID)
+	}
+
+	// 旧密钥签发的 token 仍可校验
+	old := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.StandardClaims{})
+	old.Header["kid"] = "old"
+	s, _ := old.SignedString(rsaKey)
+	if _, err := jwt.Parse(s, ks.Keyfunc); err != nil {
+		t.Fatal(err)
+	}
+	s, err = ks.Sign(jwt.StandardClaims{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := jwt.Parse(s, ks.Keyfunc); err != nil {
+		t.Fatal(err)
+	}
+
+	// 只有公钥的服务可以校验
+	verifier, _ := NewKey("cur", "ES256", nil, publicPEM(t, &ecKey.PublicKey))
+	hmac, _ := NewHMACKey("", "HS256", []byte("secret"))
+	vs, _ := NewKeySet(verifier, hmac)
+	if _, err := jwt.Parse(s, vs.Keyfunc); err != nil {
+		t.Fatal(err)
+	}
+	// 算法与密钥不一致时拒绝
+	forged := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.StandardClaims{})
+	forged.Header["kid"] = "cur"
+	fs, _ := forged.SignedString(publicPEM(t, &ecKey.PublicKey))
+	if _, err := jwt.Parse(fs, ks.Keyfunc); err == nil {
+		t.Fatal("expected algorithm mismatch error")
+	}
+
+	jwks := ks.JWKS()
+	if len(jwks) != 3 || jwks[0].Kty != "EC" || jwks[1].Kty != "OKP" || jwks[2].Kty != "RSA" {
+		t.Fatalf("unexpected jwks: %+v", jwks)
+	}
+}
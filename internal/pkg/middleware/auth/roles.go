@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// 用户角色
+const (
+	RoleUser      = "user"
+	RoleModerator = "moderator"
+	RoleAdmin     = "admin"
+)
+
+// ValidRole 是否为已定义的角色
+func ValidRole(role string) bool {
+	switch role {
+	case RoleUser, RoleModerator, RoleAdmin:
+		return true
+	}
+	return false
+}
+
+// HasRole 用户是否拥有任一角色, 管理员拥有全部角色
+func (u LoginUser) HasRole(roles ...string) bool {
+	if u.Role == RoleAdmin {
+		return true
+	}
+	for _, r := range roles {
+		if u.Role == r {
+			return true
+		}
+	}
+	return false
+}
+
+// RoleAuth 按 operation 校验角色, rules 为 operation => 允许的角色, 未声明的接口不校验;
+// 需放在 JWTAuth 之后, 未登录返回 401, 角色不符返回 403
+func RoleAuth(rules map[string][]string) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			roles, ok := rules[tr.Operation()]
+			if !ok {
+				return handler(ctx, req)
+			}
+			u, ok := FromContext(ctx)
+			if !ok {
+				return nil, errors.Unauthorized("user", "not login")
+			}
+			if !u.HasRole(roles...) {
+				return nil, errors.Forbidden("role", "permission denied")
+			}
+			return handler(ctx, req)
+		}
+	}
+}
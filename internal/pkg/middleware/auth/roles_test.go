@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+func TestRoleAuth(t *testing.T) {
+	rules := map[string][]string{"/admin": {RoleModerator}}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}
+	tests := []struct {
+		operation string
+		user      *LoginUser
+		code      int
+	}{
+		{"/public", nil, 200},
+		{"/admin", nil, 401},
+		{"/admin", &LoginUser{UserID: 1, Role: RoleUser}, 403},
+		{"/admin", &LoginUser{UserID: 1, Role: RoleModerator}, 200},
+		{"/admin", &LoginUser{UserID: 1, Role: RoleAdmin}, 200},
+	}
+	for _, tt := range tests {
+		ctx := transport.NewServerContext(context.Background(), &testTransport{header: headerCarrier{}, operation: tt.operation})
+		if tt.user != nil {
+			ctx = NewContext(ctx, *tt.user)
+		}
+		_, err := RoleAuth(rules)(handler)(ctx, nil)
+		if code := errors.Code(err); code != tt.code {
+			t.Errorf("%s %+v: got %d, want %d", tt.operation, tt.user, code, tt.code)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+type personalTokens map[string]LoginUser
+
+func (p personalTokens) AuthenticatePersonalToken(ctx context.Context, token string) (LoginUser, error) {
+	u, ok := p[token]
+	if !ok {
+		return u, errors.Unauthorized("token", "invalid token")
+	}
+	return u, nil
+}
+
+func TestScopeAuth(t *testing.T) {
+	key, _ := NewHMACKey("", "HS256", []byte("secret"))
+	ks, _ := NewKeySet(key)
+	pats := personalTokens{PersonalTokenPrefix + "ok": {UserID: 1, Scopes: []string{ScopeArticlesWrite}}}
+	rules := map[string]string{"/articles": ScopeArticlesWrite, "/profile": ScopeProfileRead}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}
+	mw := func(h func(context.Context, interface{}) (interface{}, error)) func(context.Context, interface{}) (interface{}, error) {
+		return JWTAuth(ks, nil, pats)(ScopeAuth(rules)(h))
+	}
+	session, _ := GenerateToken(ks, LoginUser{UserID: 1}, 0, time.Minute)
+	tests := []struct {
+		operation string
+		token     string
+		code      int
+	}{
+		{"/articles", PersonalTokenPrefix + "ok", 200},
+		{"/profile", PersonalTokenPrefix + "ok", 403},
+		// 未声明 scope 的接口不允许使用个人访问令牌
+		{"/tokens", PersonalTokenPrefix + "ok", 403},
+		{"/articles", PersonalTokenPrefix + "unknown", 401},
+		{"/tokens", session.Token, 200},
+	}
+	for _, tt := range tests {
+		header := headerCarrier{}
+		header.Set("Authorization", "Token "+tt.token)
+		ctx := transport.NewServerContext(context.Background(), &testTransport{header: header, operation: tt.operation})
+		_, err := mw(handler)(ctx, nil)
+		if code := errors.Code(err); code != tt.code {
+			t.Errorf("%s %s: got %d, want %d", tt.operation, tt.token, code, tt.code)
+		}
+	}
+}
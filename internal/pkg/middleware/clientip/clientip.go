@@ -0,0 +1,137 @@
+package clientip
+
+import (
+	"context"
+	"demo/internal/conf"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/peer"
+)
+
+// Resolver 解析客户端地址; 部署在反向代理后时, 只采信来自可信代理的请求头
+type Resolver struct {
+	// X-Forwarded-For、X-Real-IP 等, 为空时使用连接地址
+	header  string
+	proxies []*net.IPNet
+}
+
+// NewResolver trustedProxies 为可信代理的地址或网段(CIDR)
+func NewResolver(header string, trustedProxies []string) (*Resolver, error) {
+	r := &Resolver{header: header}
+	for _, p := range trustedProxies {
+		if !strings.Contains(p, "/") {
+			ip := net.ParseIP(p)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", p)
+			}
+			bits := 8 * len(ip.To16())
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 32
+			}
+			r.proxies = append(r.proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, n, err := net.ParseCIDR(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", p, err)
+		}
+		r.proxies = append(r.proxies, n)
+	}
+	return r, nil
+}
+
+// NewResolverFromConfig 从服务配置创建, 未配置时使用连接地址
+func NewResolverFromConfig(c *conf.Server) (*Resolver, error) {
+	return NewResolver(c.GetClientIp().GetHeader(), c.GetClientIp().GetTrustedProxies())
+}
+
+func (r *Resolver) trusted(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, n := range r.proxies {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Resolve 连接来自可信代理时, 从请求头中由右向左取第一个不是可信代理的地址, 否则为连接地址
+func (r *Resolver) Resolve(ctx context.Context) string {
+	addr := remoteIP(ctx)
+	if r.header == "" || !r.trusted(addr) {
+		return addr
+	}
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return addr
+	}
+	var values []string
+	if ht, ok := tr.(http.Transporter); ok {
+		values = ht.Request().Header.Values(r.header)
+	} else if v := tr.RequestHeader().Get(r.header); v != "" {
+		values = []string{v}
+	}
+	var hops []string
+	for _, v := range values {
+		for _, h := range strings.Split(v, ",") {
+			hops = append(hops, strings.TrimSpace(h))
+		}
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		if net.ParseIP(hops[i]) == nil {
+			// 格式错误的地址之前的内容不可信
+			break
+		}
+		addr = hops[i]
+		if !r.trusted(addr) {
+			break
+		}
+	}
+	return addr
+}
+
+type clientIPKey struct{}
+
+// Server 解析客户端地址并写入 context, HTTP 与 gRPC 共用
+func Server(r *Resolver) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			return handler(context.WithValue(ctx, clientIPKey{}, r.Resolve(ctx)), req)
+		}
+	}
+}
+
+// FromContext 返回 Server 解析的客户端地址, 未经过 Server 时为连接地址
+func FromContext(ctx context.Context) string {
+	if ip, ok := ctx.Value(clientIPKey{}).(string); ok {
+		return ip
+	}
+	return remoteIP(ctx)
+}
+
+// 连接地址, HTTP 取 RemoteAddr, gRPC 取 peer 地址
+func remoteIP(ctx context.Context) string {
+	var addr string
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		if ht, ok := tr.(http.Transporter); ok {
+			addr = ht.Request().RemoteAddr
+		}
+	}
+	if addr == "" {
+		if p, ok := peer.FromContext(ctx); ok {
+			addr = p.Addr.String()
+		}
+	}
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
@@ -0,0 +1,77 @@
+package clientip
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+type headerCarrier http.Header
+
+func (hc headerCarrier) Get(key string) string { return http.Header(hc).Get(key) }
+
+func (hc headerCarrier) Set(key string, value string) { http.Header(hc).Set(key, value) }
+
+func (hc headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(hc))
+	for k := range hc {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+type testTransport struct {
+	req *http.Request
+}
+
+func (tr *testTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (tr *testTransport) Endpoint() string                { return "" }
+func (tr *testTransport) Operation() string               { return "" }
+func (tr *testTransport) RequestHeader() transport.Header { return headerCarrier(tr.req.Header) }
+func (tr *testTransport) ReplyHeader() transport.Header   { return headerCarrier{} }
+func (tr *testTransport) Request() *http.Request          { return tr.req }
+func (tr *testTransport) PathTemplate() string            { return "" }
+
+func TestResolve(t *testing.T) {
+	r, err := NewResolver("X-Forwarded-For", []string{"10.0.0.0/8", "192.168.1.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		remote string
+		xff    []string
+		want   string
+	}{
+		// 未经过代理
+		{"1.1.1.1:1234", nil, "1.1.1.1"},
+		// 不可信的连接伪造请求头
+		{"1.1.1.1:1234", []string{"2.2.2.2"}, "1.1.1.1"},
+		{"10.0.0.1:1234", []string{"2.2.2.2"}, "2.2.2.2"},
+		// 客户端伪造的地址在左侧, 取最右侧不可信的地址
+		{"10.0.0.1:1234", []string{"3.3.3.3, 2.2.2.2, 192.168.1.1"}, "2.2.2.2"},
+		{"10.0.0.1:1234", []string{"3.3.3.3", "2.2.2.2, 10.0.0.2"}, "2.2.2.2"},
+		{"10.0.0.1:1234", []string{"garbage, 10.0.0.2"}, "10.0.0.2"},
+		{"10.0.0.1:1234", nil, "10.0.0.1"},
+	}
+	for _, tt := range tests {
+		req, _ := http.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = tt.remote
+		for _, v := range tt.xff {
+			req.Header.Add("X-Forwarded-For", v)
+		}
+		ctx := transport.NewServerContext(context.Background(), &testTransport{req: req})
+		var got string
+		_, _ = Server(r)(func(ctx context.Context, req interface{}) (interface{}, error) {
+			got = FromContext(ctx)
+			return nil, nil
+		})(ctx, nil)
+		if got != tt.want {
+			t.Errorf("%s %v: got %s, want %s", tt.remote, tt.xff, got, tt.want)
+		}
+	}
+	if _, err := NewResolver("X-Real-IP", []string{"10.0.0.0/33"}); err == nil {
+		t.Fatal("expected invalid proxy to be rejected")
+	}
+}
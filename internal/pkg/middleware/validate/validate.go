@@ -0,0 +1,103 @@
+package validate
+
+import (
+	"context"
+	"demo/internal/errors"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// protoc-gen-validate 生成的方法
+type validator interface {
+	ValidateAll() error
+}
+
+// 单个字段的校验错误, 嵌套消息的错误在 Cause 中
+type fieldError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// ValidateAll 返回的错误集合
+type multiError interface {
+	AllErrors() []error
+}
+
+// Validator 按 proto 中声明的 validate 规则校验请求, 失败时返回 422, 错误按字段列出;
+// 需放在 JWTAuth 之后, 避免未登录的请求得到参数错误
+func Validator() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			v, ok := req.(validator)
+			if !ok {
+				return handler(ctx, req)
+			}
+			if err := v.ValidateAll(); err != nil {
+				var md protoreflect.MessageDescriptor
+				if m, ok := req.(proto.Message); ok {
+					md = m.ProtoReflect().Descriptor()
+				}
+				fields := map[string]string{}
+				collect(err, md, fields)
+				return nil, errors.Validation(fields)
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// 展开嵌套消息与重复字段的错误, 以 proto 字段名为键, 每个字段只保留第一条错误
+func collect(err error, md protoreflect.MessageDescriptor, fields map[string]string) {
+	switch e := err.(type) {
+	case multiError:
+		for _, err := range e.AllErrors() {
+			collect(err, md, fields)
+		}
+	case fieldError:
+		// 重复字段带下标, 如 TagList[0]
+		field := e.Field()
+		if i := strings.IndexByte(field, '['); i >= 0 {
+			field = field[:i]
+		}
+		fd := findField(md, field)
+		switch e.Cause().(type) {
+		case multiError, fieldError:
+			var nested protoreflect.MessageDescriptor
+			if fd != nil {
+				nested = fd.Message()
+			}
+			collect(e.Cause(), nested, fields)
+			return
+		}
+		name := strings.ToLower(field)
+		if fd != nil {
+			name = string(fd.Name())
+		}
+		if _, ok := fields[name]; !ok {
+			fields[name] = e.Reason()
+		}
+	default:
+		if _, ok := fields["params"]; !ok {
+			fields["params"] = err.Error()
+		}
+	}
+}
+
+// 生成代码中的字段名为驼峰形式, 如 ArticleId、TagList
+func findField(md protoreflect.MessageDescriptor, field string) protoreflect.FieldDescriptor {
+	if md == nil {
+		return nil
+	}
+	fds := md.Fields()
+	for i := 0; i < fds.Len(); i++ {
+		fd := fds.Get(i)
+		if strings.EqualFold(strings.ReplaceAll(string(fd.Name()), "_", ""), field) {
+			return fd
+		}
+	}
+	return nil
+}
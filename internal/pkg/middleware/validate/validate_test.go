@@ -0,0 +1,55 @@
+package validate
+
+import (
+	"context"
+	v1 "demo/api/realworld/v1"
+	"demo/internal/errors"
+	"testing"
+)
+
+func TestValidator(t *testing.T) {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	tests := []struct {
+		req    interface{}
+		fields []string
+	}{
+		{&v1.RegisterRequest{User: &v1.RegisterRequest_User{Username: "jake", Email: "jake@jake.jake", Password: "jakejake"}}, nil},
+		{&v1.RegisterRequest{}, []string{"user"}},
+		{&v1.RegisterRequest{User: &v1.RegisterRequest_User{Username: "ja ke", Email: "jake", Password: "jakejake"}}, []string{"username", "email"}},
+		{&v1.LoginRequest{User: &v1.LoginRequest_User{Email: "jake@jake.jake", Password: "jakejake"}}, nil},
+		{&v1.LoginRequest{User: &v1.LoginRequest_User{Email: "", Password: "jakejake"}}, []string{"email"}},
+		{&v1.LoginRequest{User: &v1.LoginRequest_User{Email: "jake", Password: "jakejake"}}, []string{"email"}},
+		{&v1.RequestEmailVerificationRequest{Email: "jake@jake.jake"}, nil},
+		{&v1.RequestEmailVerificationRequest{Email: "jake"}, []string{"email"}},
+		{&v1.RequestPasswordResetRequest{Email: "jake@jake.jake"}, nil},
+		{&v1.RequestPasswordResetRequest{Email: ""}, []string{"email"}},
+		{&v1.RequestPasswordResetRequest{Email: "jake"}, []string{"email"}},
+		{&v1.CreateArticleRequest{Article: &v1.CreateArticleRequest_Article{Title: "t", Body: "b", TagList: []string{""}}}, []string{"tagList"}},
+		{&v1.ListArticlesRequest{Limit: 1000, Offset: -1}, []string{"limit", "offset"}},
+		{&v1.DeleteCommentRequest{ArticleId: 1}, []string{"comment_id"}},
+		{&v1.UpdateUserRequest{User: &v1.UpdateUserRequest_User{Bio: "bio"}}, nil},
+		// 没有校验规则的请求直接放行
+		{"raw", nil},
+	}
+	for _, tt := range tests {
+		_, err := Validator()(handler)(context.Background(), tt.req)
+		if len(tt.fields) == 0 {
+			if err != nil {
+				t.Errorf("%v: unexpected error %v", tt.req, err)
+			}
+			continue
+		}
+		he := errors.FromError(err)
+		if he.Code != 422 || len(he.Errors) != len(tt.fields) {
+			t.Errorf("%v: unexpected error %d %v", tt.req, he.Code, he.Errors)
+			continue
+		}
+		for _, f := range tt.fields {
+			if len(he.Errors[f]) != 1 {
+				t.Errorf("%v: missing error for %s, got %v", tt.req, f, he.Errors)
+			}
+		}
+	}
+}
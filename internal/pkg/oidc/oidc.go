@@ -0,0 +1,341 @@
+// Package oidc OpenID Connect 客户端, 只实现登录所需的授权码模式 + PKCE(S256) 与 ID token 校验
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+var DefaultScopes = []string{"openid", "email", "profile"}
+
+// JWKS 刷新的最小间隔, 避免未知 kid 的 token 触发大量请求
+const jwksRefreshInterval = time.Minute
+
+var b64 = base64.RawURLEncoding
+
+// Config 身份提供方配置
+type Config struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+// Metadata {issuer}/.well-known/openid-configuration 中用到的字段
+type Metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Token token 端点的返回
+type Token struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	IDToken     string `json:"id_token"`
+}
+
+// Claims ID token 中用到的字段
+type Claims struct {
+	Email             string `json:"email"`
+	EmailVerified     bool   `json:"email_verified"`
+	Name              string `json:"name"`
+	PreferredUsername string `json:"preferred_username"`
+	Picture           string `json:"picture"`
+	Nonce             string `json:"nonce"`
+	jwt.RegisteredClaims
+}
+
+// Client 单个身份提供方的客户端, 端点与公钥在首次使用时获取并缓存
+type Client struct {
+	c  Config
+	hc *http.Client
+
+	mu       sync.Mutex
+	meta     *Metadata
+	keys     map[string]interface{}
+	keysTime time.Time
+}
+
+func NewClient(c Config, hc *http.Client) *Client {
+	if len(c.Scopes) == 0 {
+		c.Scopes = DefaultScopes
+	}
+	if hc == nil {
+		hc = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &Client{c: c, hc: hc}
+}
+
+// RandomString 生成 state、nonce 与 code_verifier 使用的随机串
+func RandomString() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return b64.EncodeToString(b), nil
+}
+
+// S256Challenge PKCE code_challenge, 见 RFC 7636
+func S256Challenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return b64.EncodeToString(sum[:])
+}
+
+func (c *Client) getJSON(ctx context.Context, u string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("oidc: GET %s: %s", u, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// Metadata 获取并缓存提供方的端点
+func (c *Client) Metadata(ctx context.Context) (*Metadata, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.meta != nil {
+		return c.meta, nil
+	}
+	meta := new(Metadata)
+	err := c.getJSON(ctx, strings.TrimSuffix(c.c.Issuer, "/")+"/.well-known/openid-configuration", meta)
+	if err != nil {
+		return nil, err
+	}
+	if meta.Issuer != c.c.Issuer {
+		return nil, fmt.Errorf("oidc: issuer mismatch, expected %s got %s", c.c.Issuer, meta.Issuer)
+	}
+	if meta.AuthorizationEndpoint == "" || meta.TokenEndpoint == "" || meta.JWKSURI == "" {
+		return nil, errors.New("oidc: incomplete provider metadata")
+	}
+	c.meta = meta
+	return meta, nil
+}
+
+// AuthCodeURL 授权地址, 用户在提供方登录后跳转到 RedirectURL 并带上 code 与 state
+func (c *Client) AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+	meta, err := c.Metadata(ctx)
+	if err != nil {
+		return "", err
+	}
+	v := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {c.c.ClientID},
+		"redirect_uri":          {c.c.RedirectURL},
+		"scope":                 {strings.Join(c.c.Scopes, " ")},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {S256Challenge(verifier)},
+		"code_challenge_method": {"S256"},
+	}
+	sep := "?"
+	if strings.Contains(meta.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return meta.AuthorizationEndpoint + sep + v.Encode(), nil
+}
+
+// Exchange 使用 code 与 code_verifier 换取 token
+func (c *Client) Exchange(ctx context.Context, code, verifier string) (*Token, error) {
+	meta, err := c.Metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {c.c.RedirectURL},
+		"code_verifier": {verifier},
+	}
+	if c.c.ClientSecret == "" {
+		form.Set("client_id", c.c.ClientID)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, meta.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if c.c.ClientSecret != "" {
+		// client_secret_basic, 见 RFC 6749 2.3.1
+		req.SetBasicAuth(url.QueryEscape(c.c.ClientID), url.QueryEscape(c.c.ClientSecret))
+	}
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var e struct {
+			Error       string `json:"error"`
+			Description string `json:"error_description"`
+		}
+		_ = json.Unmarshal(body, &e)
+		return nil, fmt.Errorf("oidc: token exchange failed: %s %s %s", resp.Status, e.Error, e.Description)
+	}
+	t := new(Token)
+	if err := json.Unmarshal(body, t); err != nil {
+		return nil, err
+	}
+	if t.IDToken == "" {
+		return nil, errors.New("oidc: token response has no id_token")
+	}
+	return t, nil
+}
+
+// Verify 校验 ID token 的签名、issuer、audience、有效期与 nonce
+func (c *Client) Verify(ctx context.Context, rawIDToken, nonce string) (*Claims, error) {
+	meta, err := c.Metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	claims := new(Claims)
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "ES256", "ES384", "ES512"}))
+	_, err = parser.ParseWithClaims(rawIDToken, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return c.key(ctx, meta, kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("oidc: invalid id token: %w", err)
+	}
+	switch {
+	case !claims.VerifyIssuer(meta.Issuer, true):
+		return nil, errors.New("oidc: id token issuer mismatch")
+	case !claims.VerifyAudience(c.c.ClientID, true):
+		return nil, errors.New("oidc: id token audience mismatch")
+	case !claims.VerifyExpiresAt(time.Now(), true):
+		return nil, errors.New("oidc: id token expired")
+	case claims.Subject == "":
+		return nil, errors.New("oidc: id token has no subject")
+	case subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1:
+		return nil, errors.New("oidc: id token nonce mismatch")
+	}
+	return claims, nil
+}
+
+// 按 kid 查找公钥, 找不到时刷新 JWKS 以支持提供方轮换密钥
+func (c *Client) key(ctx context.Context, meta *Metadata, kid string) (interface{}, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if k, ok := c.lookup(kid); ok {
+		return k, nil
+	}
+	if time.Since(c.keysTime) < jwksRefreshInterval {
+		return nil, fmt.Errorf("oidc: unknown key id %q", kid)
+	}
+	c.keysTime = time.Now()
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := c.getJSON(ctx, meta.JWKSURI, &set); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]interface{}, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		pub, err := k.publicKey()
+		if err != nil {
+			continue
+		}
+		keys[k.Kid] = pub
+	}
+	c.keys = keys
+	if k, ok := c.lookup(kid); ok {
+		return k, nil
+	}
+	return nil, fmt.Errorf("oidc: unknown key id %q", kid)
+}
+
+// token 未带 kid 时, 提供方只有一个公钥才能确定
+func (c *Client) lookup(kid string) (interface{}, bool) {
+	if kid == "" && len(c.keys) == 1 {
+		for _, k := range c.keys {
+			return k, true
+		}
+	}
+	k, ok := c.keys[kid]
+	return k, ok
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k jwk) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := b64.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := b64.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("oidc: unsupported curve %s", k.Crv)
+		}
+		x, err := b64.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := b64.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	}
+	return nil, fmt.Errorf("oidc: unsupported key type %s", k.Kty)
+}
@@ -0,0 +1,58 @@
+package oidc_test
+
+import (
+	"context"
+	"demo/internal/pkg/oidc"
+	"demo/internal/pkg/oidc/oidctest"
+	"testing"
+)
+
+func TestClient(t *testing.T) {
+	p, err := oidctest.NewProvider("client", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+	p.SetIdentity(oidctest.Identity{Subject: "u1", Email: "a@b.c", EmailVerified: true})
+	c := oidc.NewClient(oidc.Config{Issuer: p.URL, ClientID: "client", ClientSecret: "secret", RedirectURL: "http://localhost/callback"}, nil)
+	ctx := context.Background()
+
+	login := func(verifier string) string {
+		authURL, err := c.AuthCodeURL(ctx, "state", "nonce", verifier)
+		if err != nil {
+			t.Fatal(err)
+		}
+		code, state, err := p.Authorize(authURL)
+		if err != nil || state != "state" {
+			t.Fatalf("authorize: %q %v", state, err)
+		}
+		return code
+	}
+	verifier, _ := oidc.RandomString()
+	code := login(verifier)
+	if _, err := c.Exchange(ctx, code, "wrong-verifier"); err == nil {
+		t.Fatal("expected wrong code_verifier to be rejected")
+	}
+	code = login(verifier)
+	tok, err := c.Exchange(ctx, code, verifier)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Exchange(ctx, code, verifier); err == nil {
+		t.Fatal("expected code to be single use")
+	}
+	if _, err := c.Verify(ctx, tok.IDToken, "other"); err == nil {
+		t.Fatal("expected nonce mismatch")
+	}
+	claims, err := c.Verify(ctx, tok.IDToken, "nonce")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claims.Subject != "u1" || claims.Email != "a@b.c" || !claims.EmailVerified {
+		t.Fatalf("unexpected claims: %+v", claims)
+	}
+	other := oidc.NewClient(oidc.Config{Issuer: p.URL, ClientID: "other"}, nil)
+	if _, err := other.Verify(ctx, tok.IDToken, "nonce"); err == nil {
+		t.Fatal("expected audience mismatch")
+	}
+}
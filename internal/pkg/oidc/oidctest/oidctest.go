@@ -0,0 +1,207 @@
+// Package oidctest 本地 OpenID Connect 提供方, 用于测试与本地开发, 授权请求不经过登录页直接通过
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"demo/internal/pkg/oidc"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const keyID = "oidctest"
+
+// Identity 授权时返回的用户信息
+type Identity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+type grant struct {
+	identity    Identity
+	clientID    string
+	redirectURI string
+	challenge   string
+	nonce       string
+	expiresAt   time.Time
+}
+
+// Provider 使用 httptest.Server 提供 discovery、authorize、token 与 jwks 端点
+type Provider struct {
+	*httptest.Server
+	ClientID     string
+	ClientSecret string
+
+	key *rsa.PrivateKey
+
+	mu       sync.Mutex
+	identity Identity
+	grants   map[string]*grant
+}
+
+func NewProvider(clientID, clientSecret string) (*Provider, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	p := &Provider{ClientID: clientID, ClientSecret: clientSecret, key: key, grants: map[string]*grant{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", p.discovery)
+	mux.HandleFunc("/authorize", p.authorize)
+	mux.HandleFunc("/token", p.token)
+	mux.HandleFunc("/jwks", p.jwks)
+	p.Server = httptest.NewServer(mux)
+	return p, nil
+}
+
+// SetIdentity 设置之后的授权请求登录的用户
+func (p *Provider) SetIdentity(id Identity) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.identity = id
+}
+
+// Authorize 模拟浏览器访问授权地址, 返回跳转到 redirect_uri 时带的 code 与 state
+func (p *Provider) Authorize(authURL string) (code, state string, err error) {
+	c := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	resp, err := c.Get(authURL)
+	if err != nil {
+		return "", "", err
+	}
+	resp.Body.Close()
+	loc, err := resp.Location()
+	if err != nil {
+		return "", "", err
+	}
+	q := loc.Query()
+	if e := q.Get("error"); e != "" {
+		return "", "", fmt.Errorf("oidctest: authorize: %s", e)
+	}
+	return q.Get("code"), q.Get("state"), nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func (p *Provider) discovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, oidc.Metadata{
+		Issuer:                p.URL,
+		AuthorizationEndpoint: p.URL + "/authorize",
+		TokenEndpoint:         p.URL + "/token",
+		JWKSURI:               p.URL + "/jwks",
+	})
+}
+
+func (p *Provider) authorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	redirect, err := url.Parse(q.Get("redirect_uri"))
+	if err != nil || redirect.Scheme == "" || q.Get("client_id") != p.ClientID {
+		http.Error(w, "invalid client or redirect_uri", http.StatusBadRequest)
+		return
+	}
+	rq := redirect.Query()
+	rq.Set("state", q.Get("state"))
+	if q.Get("response_type") != "code" || q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "" {
+		rq.Set("error", "invalid_request")
+	} else {
+		code, _ := oidc.RandomString()
+		p.mu.Lock()
+		p.grants[code] = &grant{
+			identity:    p.identity,
+			clientID:    q.Get("client_id"),
+			redirectURI: q.Get("redirect_uri"),
+			challenge:   q.Get("code_challenge"),
+			nonce:       q.Get("nonce"),
+			expiresAt:   time.Now().Add(time.Minute),
+		}
+		p.mu.Unlock()
+		rq.Set("code", code)
+	}
+	redirect.RawQuery = rq.Encode()
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+func (p *Provider) token(w http.ResponseWriter, r *http.Request) {
+	fail := func(e string) {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": e})
+	}
+	if err := r.ParseForm(); err != nil || r.PostForm.Get("grant_type") != "authorization_code" {
+		fail("unsupported_grant_type")
+		return
+	}
+	clientID, secret, ok := r.BasicAuth()
+	if ok {
+		clientID, _ = url.QueryUnescape(clientID)
+		secret, _ = url.QueryUnescape(secret)
+	} else {
+		clientID = r.PostForm.Get("client_id")
+	}
+	if clientID != p.ClientID || secret != p.ClientSecret {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+	code := r.PostForm.Get("code")
+	p.mu.Lock()
+	g, ok := p.grants[code]
+	delete(p.grants, code)
+	p.mu.Unlock()
+	if !ok || time.Now().After(g.expiresAt) || g.clientID != clientID || g.redirectURI != r.PostForm.Get("redirect_uri") ||
+		oidc.S256Challenge(r.PostForm.Get("code_verifier")) != g.challenge {
+		fail("invalid_grant")
+		return
+	}
+	now := time.Now()
+	claims := oidc.Claims{
+		Email:         g.identity.Email,
+		EmailVerified: g.identity.EmailVerified,
+		Name:          g.identity.Name,
+		Nonce:         g.nonce,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    p.URL,
+			Subject:   g.identity.Subject,
+			Audience:  jwt.ClaimStrings{clientID},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(5 * time.Minute)),
+		},
+	}
+	t := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	t.Header["kid"] = keyID
+	idToken, err := t.SignedString(p.key)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	access, _ := oidc.RandomString()
+	writeJSON(w, http.StatusOK, oidc.Token{AccessToken: access, TokenType: "Bearer", IDToken: idToken})
+}
+
+func (p *Provider) jwks(w http.ResponseWriter, r *http.Request) {
+	enc := base64.RawURLEncoding
+	pub := p.key.PublicKey
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": keyID,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   enc.EncodeToString(pub.N.Bytes()),
+			"e":   enc.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}},
+	})
+}
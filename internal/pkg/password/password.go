@@ -0,0 +1,145 @@
+// Package password 密码哈希, 支持 argon2id 与 bcrypt, 参数编码在哈希中以便升级后识别旧哈希
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	Argon2id = "argon2id"
+	Bcrypt   = "bcrypt"
+)
+
+// ErrInvalidHash 无法识别的哈希格式
+var ErrInvalidHash = errors.New("password: invalid hash format")
+
+// Argon2idParams argon2id 参数, Memory 单位为 KiB
+type Argon2idParams struct {
+	Time    uint32
+	Memory  uint32
+	Threads uint8
+	SaltLen uint32
+	KeyLen  uint32
+}
+
+// DefaultArgon2idParams OWASP 推荐的参数
+var DefaultArgon2idParams = Argon2idParams{Time: 3, Memory: 64 * 1024, Threads: 2, SaltLen: 16, KeyLen: 32}
+
+// Hasher 使用指定算法生成哈希, 校验时兼容两种算法
+type Hasher struct {
+	algorithm  string
+	argon      Argon2idParams
+	bcryptCost int
+}
+
+// NewHasher algorithm 为空时使用 argon2id
+func NewHasher(algorithm string, argon Argon2idParams, bcryptCost int) (*Hasher, error) {
+	if algorithm == "" {
+		algorithm = Argon2id
+	}
+	if algorithm != Argon2id && algorithm != Bcrypt {
+		return nil, fmt.Errorf("password: unsupported algorithm %s", algorithm)
+	}
+	if argon.Time == 0 || argon.Memory == 0 || argon.Threads == 0 {
+		argon = DefaultArgon2idParams
+	}
+	if argon.SaltLen == 0 {
+		argon.SaltLen = DefaultArgon2idParams.SaltLen
+	}
+	if argon.KeyLen == 0 {
+		argon.KeyLen = DefaultArgon2idParams.KeyLen
+	}
+	if bcryptCost == 0 {
+		bcryptCost = bcrypt.DefaultCost
+	}
+	if bcryptCost < bcrypt.MinCost || bcryptCost > bcrypt.MaxCost {
+		return nil, fmt.Errorf("password: invalid bcrypt cost %d", bcryptCost)
+	}
+	return &Hasher{algorithm: algorithm, argon: argon, bcryptCost: bcryptCost}, nil
+}
+
+var b64 = base64.RawStdEncoding
+
+// Hash 生成哈希, argon2id 使用 PHC 格式: $argon2id$v=19$m=65536,t=3,p=2$<salt>$<key>
+func (h *Hasher) Hash(password string) (string, error) {
+	if h.algorithm == Bcrypt {
+		b, err := bcrypt.GenerateFromPassword([]byte(password), h.bcryptCost)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	}
+	p := h.argon
+	salt := make([]byte, p.SaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(password), salt, p.Time, p.Memory, p.Threads, p.KeyLen)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, p.Memory, p.Time, p.Threads, b64.EncodeToString(salt), b64.EncodeToString(key)), nil
+}
+
+// 解析 argon2id 哈希
+func decodeArgon2id(encoded string) (p Argon2idParams, salt, key []byte, err error) {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != Argon2id {
+		return p, nil, nil, ErrInvalidHash
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return p, nil, nil, ErrInvalidHash
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.Memory, &p.Time, &p.Threads); err != nil {
+		return p, nil, nil, ErrInvalidHash
+	}
+	if salt, err = b64.DecodeString(parts[4]); err != nil {
+		return p, nil, nil, ErrInvalidHash
+	}
+	if key, err = b64.DecodeString(parts[5]); err != nil {
+		return p, nil, nil, ErrInvalidHash
+	}
+	p.SaltLen, p.KeyLen = uint32(len(salt)), uint32(len(key))
+	return p, salt, key, nil
+}
+
+func isBcrypt(encoded string) bool {
+	return strings.HasPrefix(encoded, "$2a$") || strings.HasPrefix(encoded, "$2b$") || strings.HasPrefix(encoded, "$2y$")
+}
+
+// Verify 校验密码, 哈希格式无法识别时返回 ErrInvalidHash
+func (h *Hasher) Verify(encoded, password string) (bool, error) {
+	switch {
+	case strings.HasPrefix(encoded, "$argon2id$"):
+		p, salt, key, err := decodeArgon2id(encoded)
+		if err != nil {
+			return false, err
+		}
+		other := argon2.IDKey([]byte(password), salt, p.Time, p.Memory, p.Threads, p.KeyLen)
+		return subtle.ConstantTimeCompare(key, other) == 1, nil
+	case isBcrypt(encoded):
+		err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+		return err == nil, err
+	}
+	return false, ErrInvalidHash
+}
+
+// NeedsRehash 哈希的算法或参数与当前配置不一致, 应在登录成功后重新生成
+func (h *Hasher) NeedsRehash(encoded string) bool {
+	if h.algorithm == Bcrypt {
+		cost, err := bcrypt.Cost([]byte(encoded))
+		return err != nil || cost != h.bcryptCost
+	}
+	p, _, _, err := decodeArgon2id(encoded)
+	return err != nil || p != h.argon
+}
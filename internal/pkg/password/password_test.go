@@ -0,0 +1,51 @@
+package password
+
+import (
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestHasher(t *testing.T) {
+	fast := Argon2idParams{Time: 1, Memory: 1024, Threads: 1}
+	argon, err := NewHasher(Argon2id, fast, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	legacy, err := NewHasher(Bcrypt, fast, bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, h := range []*Hasher{argon, legacy} {
+		encoded, err := h.Hash("correct horse")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok, err := h.Verify(encoded, "correct horse"); !ok || err != nil {
+			t.Fatalf("%s: expected match, got %v %v", h.algorithm, ok, err)
+		}
+		if ok, _ := h.Verify(encoded, "wrong"); ok {
+			t.Fatalf("%s: expected mismatch", h.algorithm)
+		}
+		if h.NeedsRehash(encoded) {
+			t.Fatalf("%s: fresh hash should not need rehash", h.algorithm)
+		}
+	}
+
+	// 旧的 bcrypt 哈希可以校验, 并需要升级为 argon2id
+	old, _ := legacy.Hash("correct horse")
+	if ok, err := argon.Verify(old, "correct horse"); !ok || err != nil {
+		t.Fatalf("expected bcrypt hash to verify, got %v %v", ok, err)
+	}
+	if !argon.NeedsRehash(old) {
+		t.Fatal("expected bcrypt hash to need rehash")
+	}
+	stronger, _ := NewHasher(Argon2id, Argon2idParams{Time: 2, Memory: 1024, Threads: 1}, 0)
+	encoded, _ := argon.Hash("correct horse")
+	if !stronger.NeedsRehash(encoded) {
+		t.Fatal("expected hash with old params to need rehash")
+	}
+	if _, err := argon.Verify("", "correct horse"); err != ErrInvalidHash {
+		t.Fatalf("expected ErrInvalidHash, got %v", err)
+	}
+}
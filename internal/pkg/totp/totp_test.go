@@ -0,0 +1,37 @@
+package totp
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+)
+
+func TestCode(t *testing.T) {
+	// RFC 6238 附录 B 的 SHA1 测试向量, 取后 6 位
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, tt := range tests {
+		code, err := Code(secret, time.Unix(tt.unix, 0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if code != tt.code {
+			t.Errorf("t=%d: got %s, want %s", tt.unix, code, tt.code)
+		}
+	}
+	now := time.Unix(1111111109, 0)
+	if !Validate(secret, "081804", now.Add(Period*time.Second)) {
+		t.Error("expected code from previous window to be valid")
+	}
+	if Validate(secret, "081804", now.Add(2*Period*time.Second)) {
+		t.Error("expected code outside skew to be invalid")
+	}
+}
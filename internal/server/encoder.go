@@ -16,6 +16,9 @@ func errorEncoder(w stdhttp.ResponseWriter, r *stdhttp.Request, err error) {
 		return
 	}
 	w.Header().Set("Content-Type", "application/"+codec.Name())
+	if v := se.Metadata["retry_after"]; v != "" {
+		w.Header().Set("Retry-After", v)
+	}
 	if se.Code > 99 && se.Code < 600 {
 		w.WriteHeader(se.Code)
 	} else {
@@ -3,6 +3,8 @@ package server
 import (
 	"demo/internal/errors"
 	"encoding/json"
+	"net/http/httptest"
+	"time"
 
 	"fmt"
 	"testing"
@@ -16,3 +18,12 @@ func TestEncoder(t *testing.T) {
 	b, _ := json.Marshal(a)
 	fmt.Println(string(b))
 }
+
+func TestEncoderRetryAfter(t *testing.T) {
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("POST", "/api/users/login", nil)
+	errorEncoder(w, r, errors.TooManyRequests("login", "locked", 1500*time.Millisecond))
+	if w.Code != 429 || w.Header().Get("Retry-After") != "2" {
+		t.Fatalf("unexpected response: %d %q", w.Code, w.Header().Get("Retry-After"))
+	}
+}
@@ -3,18 +3,29 @@ package server
 import (
 	v1 "demo/api/realworld/v1"
 	"demo/internal/conf"
+	"demo/internal/pkg/middleware/auth"
+	"demo/internal/pkg/middleware/clientip"
+	"demo/internal/pkg/middleware/validate"
 	"demo/internal/service"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, jwt *conf.JWT, rwsrv *service.RealworldService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, ks *auth.KeySet, checker auth.RevocationChecker, pats auth.PersonalTokenAuthenticator, ips *clientip.Resolver, rwsrv *service.RealworldService, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
+			clientip.Server(ips),
+			// token 从 metadata 的 authorization 读取
+			selector.Server(auth.JWTAuth(ks, checker, pats)).Match(NewSkipListMatcher()).Build(),
+			selector.Server(auth.OptionalJWTAuth(ks, checker, pats)).Match(NewOptionalAuthMatcher()).Build(),
+			auth.RoleAuth(operationRoles),
+			auth.ScopeAuth(operationScopes),
+			validate.Validator(),
 		),
 	}
 	if c.Grpc.Network != "" {
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"context"
+	v1 "demo/api/realworld/v1"
+	"demo/internal/conf"
+	"demo/internal/pkg/middleware/auth"
+	"demo/internal/pkg/middleware/clientip"
+	"demo/internal/service"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestGRPCAuth(t *testing.T) {
+	key, _ := auth.NewHMACKey("", "HS256", []byte("secret"))
+	ks, _ := auth.NewKeySet(key)
+	c := &conf.Server{Grpc: &conf.Server_GRPC{Addr: "127.0.0.1:0"}}
+	srv := NewGRPCServer(c, ks, nil, nil, &clientip.Resolver{}, &service.RealworldService{}, log.DefaultLogger)
+	endpoint, err := srv.Endpoint()
+	if err != nil {
+		t.Fatal(err)
+	}
+	go srv.Start(context.Background())
+	defer srv.Stop(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := grpc.DialInsecure(ctx, grpc.WithEndpoint(endpoint.Host))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := v1.NewRealworldClient(conn)
+
+	_, err = client.GetCurrentUser(ctx, &v1.GetCurrentUserRequest{})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated without token, got %v", err)
+	}
+	md := metadata.Pairs("authorization", "Token invalid")
+	_, err = client.GetCurrentUser(metadata.NewOutgoingContext(ctx, md), &v1.GetCurrentUserRequest{})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated with invalid token, got %v", err)
+	}
+
+	// 允许匿名访问的接口, 携带 token 时仍需校验
+	_, err = client.GetProfile(ctx, &v1.GetProfileRequest{})
+	if status.Code(err) == codes.Unauthenticated {
+		t.Fatalf("expected anonymous GetProfile to pass auth, got %v", err)
+	}
+	_, err = client.GetProfile(metadata.NewOutgoingContext(ctx, md), &v1.GetProfileRequest{})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated with invalid token, got %v", err)
+	}
+}
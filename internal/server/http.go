@@ -1,10 +1,11 @@
 package server
 
 import (
-	"context"
 	v1 "demo/api/realworld/v1"
 	"demo/internal/conf"
 	"demo/internal/pkg/middleware/auth"
+	"demo/internal/pkg/middleware/clientip"
+	"demo/internal/pkg/middleware/validate"
 	"demo/internal/service"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -14,29 +15,22 @@ import (
 	"github.com/gorilla/handlers"
 )
 
-func NewSkipListMatcher() selector.MatchFunc {
-	skipList := make(map[string]struct{})
-	skipList["/realworld.v1.Realworld/Login"] = struct{}{}
-	skipList["/realworld.v1.Realworld/Register"] = struct{}{}
-	return func(ctx context.Context, operation string) bool {
-		if _, ok := skipList[operation]; ok {
-			return false
-		}
-		return true
-	}
-}
-
 // NewHTTPServer new a HTTP server.
-func NewHTTPServer(c *conf.Server, jwtc *conf.JWT, rwsrv *service.RealworldService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, ks *auth.KeySet, checker auth.RevocationChecker, pats auth.PersonalTokenAuthenticator, ips *clientip.Resolver, rwsrv *service.RealworldService, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.ErrorEncoder(errorEncoder),
 		http.Middleware(
 			recovery.Recovery(),
-			selector.Server(auth.JWTAuth([]byte(jwtc.Secret))).Match(NewSkipListMatcher()).Build(),
+			clientip.Server(ips),
+			selector.Server(auth.JWTAuth(ks, checker, pats)).Match(NewSkipListMatcher()).Build(),
+			selector.Server(auth.OptionalJWTAuth(ks, checker, pats)).Match(NewOptionalAuthMatcher()).Build(),
+			auth.RoleAuth(operationRoles),
+			auth.ScopeAuth(operationScopes),
+			validate.Validator(),
 		),
 		http.Filter(handlers.CORS(
 			handlers.AllowedHeaders([]string{"X-Requested-With", "Content-Type", "Authorization"}),
-			handlers.AllowedMethods([]string{"GET", "POST", "PUT", "HEAD", "OPTIONS"}),
+			handlers.AllowedMethods([]string{"GET", "POST", "PUT", "DELETE", "HEAD", "OPTIONS"}),
 			handlers.AllowedOrigins([]string{"*"}),
 		)),
 	}
@@ -50,6 +44,7 @@ func NewHTTPServer(c *conf.Server, jwtc *conf.JWT, rwsrv *service.RealworldServi
 		opts = append(opts, http.Timeout(c.Http.Timeout.AsDuration()))
 	}
 	srv := http.NewServer(opts...)
+	srv.Handle("/.well-known/jwks.json", ks.JWKSHandler())
 	v1.RegisterRealworldHTTPServer(srv, rwsrv)
 	return srv
 }
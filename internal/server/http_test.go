@@ -0,0 +1,31 @@
+package server
+
+import (
+	"demo/internal/conf"
+	"demo/internal/pkg/middleware/auth"
+	"demo/internal/pkg/middleware/clientip"
+	"demo/internal/service"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 浏览器跨域调用前的预检请求需放行所有用到的方法
+func TestHTTPCORS(t *testing.T) {
+	key, _ := auth.NewHMACKey("", "HS256", []byte("secret"))
+	ks, _ := auth.NewKeySet(key)
+	c := &conf.Server{Http: &conf.Server_HTTP{}}
+	srv := NewHTTPServer(c, ks, nil, nil, &clientip.Resolver{}, &service.RealworldService{}, log.DefaultLogger)
+	for _, method := range []string{"GET", "POST", "PUT", "DELETE"} {
+		req := httptest.NewRequest(http.MethodOptions, "/api/articles/slug", nil)
+		req.Header.Set("Origin", "http://example.com")
+		req.Header.Set("Access-Control-Request-Method", method)
+		w := httptest.NewRecorder()
+		srv.ServeHTTP(w, req)
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: expected preflight to be allowed, got %d", method, w.Code)
+		}
+	}
+}
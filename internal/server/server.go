@@ -1,8 +1,101 @@
 package server
 
 import (
+	"context"
+	"demo/internal/pkg/middleware/auth"
+	"demo/internal/pkg/middleware/clientip"
+
+	"github.com/go-kratos/kratos/v2/middleware/selector"
 	"github.com/google/wire"
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewHTTPServer, NewGRPCServer)
+var ProviderSet = wire.NewSet(NewHTTPServer, NewGRPCServer, clientip.NewResolverFromConfig)
+
+const operationPrefix = "/realworld.v1.Realworld/"
+
+// 不需要登录的接口
+var publicOperations = map[string]struct{}{
+	operationPrefix + "Login":        {},
+	operationPrefix + "Register":     {},
+	operationPrefix + "GetTags":      {},
+	operationPrefix + "RefreshToken": {},
+	operationPrefix + "LoginMFA":     {},
+	// 发送邮件的接口按邮箱查找用户且不暴露邮箱是否注册, 确认接口通过邮件中的 token 校验身份
+	operationPrefix + "RequestEmailVerification": {},
+	operationPrefix + "ConfirmEmailVerification": {},
+	operationPrefix + "RequestPasswordReset":     {},
+	operationPrefix + "ConfirmPasswordReset":     {},
+}
+
+// 允许匿名访问的接口, 登录时返回与当前用户相关的字段(following、favorited)
+var optionalAuthOperations = map[string]struct{}{
+	operationPrefix + "ListArticles":      {},
+	operationPrefix + "GetArticle":        {},
+	operationPrefix + "GetArticleBySlug":  {},
+	operationPrefix + "GetComments":       {},
+	operationPrefix + "GetCommentsBySlug": {},
+	operationPrefix + "GetProfile":        {},
+	// 已登录时为绑定当前账号
+	operationPrefix + "StartOIDCLogin": {},
+	// 绑定账号时需携带发起绑定的用户的 token
+	operationPrefix + "OIDCCallback": {},
+}
+
+// 需要指定角色的接口, 由 auth.RoleAuth 校验
+var operationRoles = map[string][]string{
+	operationPrefix + "SetUserRole": {auth.RoleAdmin},
+	operationPrefix + "UnlockUser":  {auth.RoleAdmin},
+}
+
+// 个人访问令牌可以调用的接口及需要的 scope, 未列出的接口(账号、令牌管理等)只能使用登录 token
+var operationScopes = map[string]string{
+	operationPrefix + "GetCurrentUser":          auth.ScopeProfileRead,
+	operationPrefix + "GetProfile":              auth.ScopeProfileRead,
+	operationPrefix + "FollowUser":              auth.ScopeProfileWrite,
+	operationPrefix + "UnfollowUser":            auth.ScopeProfileWrite,
+	operationPrefix + "ListArticles":            auth.ScopeArticlesRead,
+	operationPrefix + "FeedArticles":            auth.ScopeArticlesRead,
+	operationPrefix + "GetArticle":              auth.ScopeArticlesRead,
+	operationPrefix + "GetArticleBySlug":        auth.ScopeArticlesRead,
+	operationPrefix + "CreateArticle":           auth.ScopeArticlesWrite,
+	operationPrefix + "UpdateArticle":           auth.ScopeArticlesWrite,
+	operationPrefix + "UpdateArticleBySlug":     auth.ScopeArticlesWrite,
+	operationPrefix + "DeleteArticle":           auth.ScopeArticlesWrite,
+	operationPrefix + "DeleteArticleBySlug":     auth.ScopeArticlesWrite,
+	operationPrefix + "RestoreArticle":          auth.ScopeArticlesWrite,
+	operationPrefix + "PurgeArticle":            auth.ScopeArticlesWrite,
+	operationPrefix + "FavoriteArticle":         auth.ScopeArticlesWrite,
+	operationPrefix + "FavoriteArticleBySlug":   auth.ScopeArticlesWrite,
+	operationPrefix + "UnfavoriteArticle":       auth.ScopeArticlesWrite,
+	operationPrefix + "UnfavoriteArticleBySlug": auth.ScopeArticlesWrite,
+	operationPrefix + "GetComments":             auth.ScopeCommentsRead,
+	operationPrefix + "GetCommentsBySlug":       auth.ScopeCommentsRead,
+	operationPrefix + "AddComments":             auth.ScopeCommentsWrite,
+	operationPrefix + "AddCommentsBySlug":       auth.ScopeCommentsWrite,
+	operationPrefix + "DeleteComment":           auth.ScopeCommentsWrite,
+	operationPrefix + "DeleteCommentBySlug":     auth.ScopeCommentsWrite,
+	operationPrefix + "RestoreComment":          auth.ScopeCommentsWrite,
+	operationPrefix + "PurgeComment":            auth.ScopeCommentsWrite,
+}
+
+// NewSkipListMatcher 匹配必须登录的接口, HTTP 与 gRPC 共用
+func NewSkipListMatcher() selector.MatchFunc {
+	return func(ctx context.Context, operation string) bool {
+		if _, ok := publicOperations[operation]; ok {
+			return false
+		}
+		if _, ok := optionalAuthOperations[operation]; ok {
+			return false
+		}
+		return true
+	}
+}
+
+// NewOptionalAuthMatcher 匹配允许匿名访问的接口
+func NewOptionalAuthMatcher() selector.MatchFunc {
+	return func(ctx context.Context, operation string) bool {
+		_, ok := optionalAuthOperations[operation]
+		return ok
+	}
+}
@@ -1,8 +1,12 @@
 package service
 
 import (
+	"context"
 	v1 "demo/api/realworld/v1"
 	"demo/internal/biz"
+	"demo/internal/pkg/middleware/clientip"
+
+	"github.com/go-kratos/kratos/v2/transport"
 
 	"github.com/google/wire"
 
@@ -17,9 +21,28 @@ type RealworldService struct {
 
 	uc  *biz.UserUsecase
 	sc  *biz.SocialUsecase
+	tu  *biz.TokenUsecase
+	au  *biz.AccountUsecase
+	mu  *biz.MFAUsecase
+	ou  *biz.OIDCUsecase
+	pu  *biz.PersonalTokenUsecase
 	log *log.Helper
 }
 
-func NewRealworldService(uc *biz.UserUsecase, sc *biz.SocialUsecase, logger log.Logger) *RealworldService {
-	return &RealworldService{uc: uc, sc: sc, log: log.NewHelper(logger)}
+func NewRealworldService(uc *biz.UserUsecase, sc *biz.SocialUsecase, tu *biz.TokenUsecase, au *biz.AccountUsecase, mu *biz.MFAUsecase, ou *biz.OIDCUsecase, pu *biz.PersonalTokenUsecase, logger log.Logger) *RealworldService {
+	return &RealworldService{uc: uc, sc: sc, tu: tu, au: au, mu: mu, ou: ou, pu: pu, log: log.NewHelper(logger)}
+}
+
+// 客户端地址, 由 clientip 中间件解析, 部署在反向代理后时为可信代理转发的地址
+func clientIP(ctx context.Context) string {
+	return clientip.FromContext(ctx)
+}
+
+// 将客户端地址与 User-Agent 写入 context, 签发 token 时记录到会话
+func withClient(ctx context.Context) context.Context {
+	ci := biz.ClientInfo{IP: clientIP(ctx)}
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		ci.UserAgent = tr.RequestHeader().Get("User-Agent")
+	}
+	return biz.NewClientContext(ctx, ci)
 }
@@ -4,6 +4,10 @@ import (
 	"context"
 	v1 "demo/api/realworld/v1"
 	"demo/internal/biz"
+	"demo/internal/errors"
+	"strconv"
+
+	"github.com/go-kratos/kratos/v2/transport"
 )
 
 // 创建文章
@@ -13,7 +17,6 @@ func (s *RealworldService) CreateArticle(ctx context.Context, req *v1.CreateArti
 		Description: req.Article.Description,
 		Body:        req.Article.Body,
 		TagList:     req.Article.TagList,
-		Username:    ctx.Value("loginUser").(string),
 	})
 	if err != nil {
 		return nil, err
@@ -36,7 +39,7 @@ func (s *RealworldService) ListArticles(ctx context.Context, req *v1.ListArticle
 	if req.Favorited != "" {
 		filter["favorited"] = req.Favorited
 	}
-	ars, err := s.sc.ListArticles(ctx, biz.ListLimit(req.Limit), biz.ListLimit(req.Offset), biz.ListFilter(filter))
+	ars, count, err := s.sc.ListArticles(ctx, biz.ListLimit(req.Limit), biz.ListOffset(req.Offset), biz.ListFilter(filter))
 	if err != nil {
 		return nil, err
 	}
@@ -45,13 +48,14 @@ func (s *RealworldService) ListArticles(ctx context.Context, req *v1.ListArticle
 		articles = append(articles, formatArticleReply(v))
 	}
 	return &v1.MultipleArticlesReply{
-		Articles: articles,
+		Articles:      articles,
+		ArticlesCount: uint32(count),
 	}, nil
 }
 
-// 文章列表
+// 关注的作者的文章列表
 func (s *RealworldService) FeedArticles(ctx context.Context, req *v1.FeedArticlesRequest) (*v1.MultipleArticlesReply, error) {
-	ars, err := s.sc.ListArticles(ctx, biz.ListLimit(req.Limit), biz.ListLimit(req.Offset))
+	ars, count, err := s.sc.FeedArticles(ctx, biz.ListLimit(req.Limit), biz.ListOffset(req.Offset))
 	if err != nil {
 		return nil, err
 	}
@@ -60,22 +64,26 @@ func (s *RealworldService) FeedArticles(ctx context.Context, req *v1.FeedArticle
 		articles = append(articles, formatArticleReply(v))
 	}
 	return &v1.MultipleArticlesReply{
-		Articles: articles,
+		Articles:      articles,
+		ArticlesCount: uint32(count),
 	}, nil
-
 }
 
 // 格式化文章
 func formatArticleReply(ar *biz.Article) *v1.Article {
 	return &v1.Article{
+		ArticleId:      uint32(ar.ID),
+		Slug:           ar.Slug,
 		Title:          ar.Title,
 		Body:           ar.Body,
 		Description:    ar.Description,
+		TagList:        ar.TagList,
 		CreatedAt:      ar.CreatedAt.String(),
 		UpdatedAt:      ar.UpdatedAt.String(),
 		Favorited:      ar.Favorited,
 		FavoritesCount: uint32(ar.FavoritesCount),
 		Author: &v1.Author{
+			UserId:    int64(ar.Author.UserID),
 			Bio:       ar.Author.Bio,
 			Username:  ar.Author.Username,
 			Image:     ar.Author.Image,
@@ -121,39 +129,238 @@ func (s *RealworldService) DeleteArticle(ctx context.Context, req *v1.DeleteArti
 	}, nil
 }
 
-// func (s *RealworldService) FavoriteArticle(ctx context.Context, req *v1.FavoriteArticleRequest) (*v1.SingleArticlesReply, error) {
-
-// 	return &v1.SingleArticlesReply{}, nil
-
-// }
+// 恢复已删除的文章
+func (s *RealworldService) RestoreArticle(ctx context.Context, req *v1.RestoreArticleRequest) (*v1.SingleArticlesReply, error) {
+	do, err := s.sc.RestoreArticle(ctx, int(req.ArticleId))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SingleArticlesReply{
+		Article: formatArticleReply(do),
+	}, nil
+}
 
-// func (s *RealworldService) UnfavoriteArticle(ctx context.Context, req *v1.UnfavoriteArticleRequest) (*v1.SingleArticlesReply, error) {
+// 彻底删除文章
+func (s *RealworldService) PurgeArticle(ctx context.Context, req *v1.PurgeArticleRequest) (*v1.SingleArticlesReply, error) {
+	do, err := s.sc.PurgeArticle(ctx, int(req.ArticleId))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SingleArticlesReply{
+		Article: formatArticleReply(do),
+	}, nil
+}
 
-// 	return &v1.SingleArticlesReply{}, nil
+// 点赞文章
+func (s *RealworldService) FavoriteArticle(ctx context.Context, req *v1.FavoriteArticleRequest) (*v1.SingleArticlesReply, error) {
+	do, err := s.sc.FavoriteArticle(ctx, int(req.ArticleId))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SingleArticlesReply{
+		Article: formatArticleReply(do),
+	}, nil
+}
 
-// }
+// 取消点赞文章
+func (s *RealworldService) UnfavoriteArticle(ctx context.Context, req *v1.UnfavoriteArticleRequest) (*v1.SingleArticlesReply, error) {
+	do, err := s.sc.UnfavoriteArticle(ctx, int(req.ArticleId))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SingleArticlesReply{
+		Article: formatArticleReply(do),
+	}, nil
+}
 
 // 添加评论
 func (s *RealworldService) AddComments(ctx context.Context, req *v1.AddCommentsRequest) (*v1.SingleCommentReply, error) {
+	if req.Comment == nil {
+		return nil, errors.ParamInvalid("body", "cannot empty")
+	}
+	c, err := s.sc.AddComment(ctx, int(req.ArticleId), req.Comment.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SingleCommentReply{
+		Comment: formatCommentReply(c),
+	}, nil
+}
 
-	return &v1.SingleCommentReply{}, nil
+// 获取文章评论
+func (s *RealworldService) GetComments(ctx context.Context, req *v1.GetCommentsRequest) (*v1.MultipleCommentsReply, error) {
+	cs, err := s.sc.ListComments(ctx, int(req.ArticleId))
+	if err != nil {
+		return nil, err
+	}
+	comments := []*v1.Comment{}
+	for _, v := range cs {
+		comments = append(comments, formatCommentReply(v))
+	}
+	return &v1.MultipleCommentsReply{
+		Comments: comments,
+	}, nil
+}
 
+// 删除评论
+func (s *RealworldService) DeleteComment(ctx context.Context, req *v1.DeleteCommentRequest) (*v1.SingleCommentReply, error) {
+	c, err := s.sc.DeleteComment(ctx, int(req.ArticleId), uint(req.CommentId))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SingleCommentReply{
+		Comment: formatCommentReply(c),
+	}, nil
 }
 
-// func (s *RealworldService) GetComments(ctx context.Context, req *v1.GetCommentsRequest) (*v1.MultipleCommentsReply, error) {
+// 恢复已删除的评论
+func (s *RealworldService) RestoreComment(ctx context.Context, req *v1.RestoreCommentRequest) (*v1.SingleCommentReply, error) {
+	c, err := s.sc.RestoreComment(ctx, int(req.ArticleId), uint(req.CommentId))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SingleCommentReply{
+		Comment: formatCommentReply(c),
+	}, nil
+}
 
-// 	return &v1.MultipleCommentsReply{}, nil
+// 彻底删除评论
+func (s *RealworldService) PurgeComment(ctx context.Context, req *v1.PurgeCommentRequest) (*v1.SingleCommentReply, error) {
+	c, err := s.sc.PurgeComment(ctx, int(req.ArticleId), uint(req.CommentId))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SingleCommentReply{
+		Comment: formatCommentReply(c),
+	}, nil
+}
 
-// }
+// 格式化评论
+func formatCommentReply(c *biz.Comment) *v1.Comment {
+	rv := &v1.Comment{
+		CommentId: uint32(c.ID),
+		Body:      c.Body,
+		CreatedAt: c.CreatedAt.String(),
+		UpdatedAt: c.UpdatedAt.String(),
+	}
+	if c.Author != nil {
+		rv.Author = &v1.Author{
+			UserId:    int64(c.Author.UserID),
+			Username:  c.Author.Username,
+			Bio:       c.Author.Bio,
+			Image:     c.Author.Image,
+			Following: c.Author.Following,
+		}
+	}
+	return rv
+}
 
-// func (s *RealworldService) DeleteComment(ctx context.Context, req *v1.DeleteCommentRequest) (*v1.SingleCommentReply, error) {
+// 热门标签
+func (s *RealworldService) GetTags(ctx context.Context, req *v1.GetTagsRequest) (*v1.ListTagsReply, error) {
+	tcs, err := s.sc.ListTags(ctx, int(req.Days), int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	rv := &v1.ListTagsReply{
+		Tags:      []string{},
+		TagCounts: []*v1.ListTagsReply_Tag{},
+	}
+	for _, v := range tcs {
+		rv.Tags = append(rv.Tags, v.Tag)
+		rv.TagCounts = append(rv.TagCounts, &v1.ListTagsReply_Tag{
+			Tag:           v.Tag,
+			ArticlesCount: uint32(v.ArticlesCount),
+		})
+	}
+	return rv, nil
+}
 
-// 	return &v1.SingleCommentReply{}, nil
+// 根据 slug 获取文章详情, HTTP 请求使用旧 slug 时重定向到当前 slug; 使用文章ID时直接返回, 兼容旧客户端
+func (s *RealworldService) GetArticleBySlug(ctx context.Context, req *v1.GetArticleBySlugRequest) (*v1.SingleArticlesReply, error) {
+	id, err := s.sc.ResolveSlug(ctx, req.Slug)
+	if err != nil {
+		return nil, err
+	}
+	do, err := s.sc.GetArticle(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if do.Slug != "" && do.Slug != req.Slug && !isArticleID(req.Slug) {
+		if tr, ok := transport.FromServerContext(ctx); ok && tr.Kind() == transport.KindHTTP {
+			tr.ReplyHeader().Set("Location", "/api/articles/"+do.Slug)
+			return nil, errors.MovedPermanently("article", "moved to "+do.Slug)
+		}
+	}
+	return &v1.SingleArticlesReply{
+		Article: formatArticleReply(do),
+	}, nil
+}
 
-// }
+// 根据 slug 更新文章
+func (s *RealworldService) UpdateArticleBySlug(ctx context.Context, req *v1.UpdateArticleBySlugRequest) (*v1.SingleArticlesReply, error) {
+	id, err := s.sc.ResolveSlug(ctx, req.Slug)
+	if err != nil {
+		return nil, err
+	}
+	return s.UpdateArticle(ctx, &v1.UpdateArticleRequest{ArticleId: int64(id), Article: req.Article})
+}
 
-// func (s *RealworldService) GetTags(ctx context.Context, req *v1.GetTagsRequest) (*v1.ListTagsReply, error) {
+// 根据 slug 删除文章
+func (s *RealworldService) DeleteArticleBySlug(ctx context.Context, req *v1.DeleteArticleBySlugRequest) (*v1.SingleArticlesReply, error) {
+	id, err := s.sc.ResolveSlug(ctx, req.Slug)
+	if err != nil {
+		return nil, err
+	}
+	return s.DeleteArticle(ctx, &v1.DeleteArticleRequest{ArticleId: int64(id)})
+}
+
+// 根据 slug 添加评论
+func (s *RealworldService) AddCommentsBySlug(ctx context.Context, req *v1.AddCommentsBySlugRequest) (*v1.SingleCommentReply, error) {
+	id, err := s.sc.ResolveSlug(ctx, req.Slug)
+	if err != nil {
+		return nil, err
+	}
+	return s.AddComments(ctx, &v1.AddCommentsRequest{ArticleId: int64(id), Comment: req.Comment})
+}
 
-// 	return &v1.ListTagsReply{}, nil
+// 根据 slug 获取评论
+func (s *RealworldService) GetCommentsBySlug(ctx context.Context, req *v1.GetCommentsBySlugRequest) (*v1.MultipleCommentsReply, error) {
+	id, err := s.sc.ResolveSlug(ctx, req.Slug)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetComments(ctx, &v1.GetCommentsRequest{ArticleId: int64(id)})
+}
 
-// }
+// 根据 slug 删除评论
+func (s *RealworldService) DeleteCommentBySlug(ctx context.Context, req *v1.DeleteCommentBySlugRequest) (*v1.SingleCommentReply, error) {
+	id, err := s.sc.ResolveSlug(ctx, req.Slug)
+	if err != nil {
+		return nil, err
+	}
+	return s.DeleteComment(ctx, &v1.DeleteCommentRequest{ArticleId: int64(id), CommentId: req.CommentId})
+}
+
+// 根据 slug 点赞文章
+func (s *RealworldService) FavoriteArticleBySlug(ctx context.Context, req *v1.FavoriteArticleBySlugRequest) (*v1.SingleArticlesReply, error) {
+	id, err := s.sc.ResolveSlug(ctx, req.Slug)
+	if err != nil {
+		return nil, err
+	}
+	return s.FavoriteArticle(ctx, &v1.FavoriteArticleRequest{ArticleId: int64(id)})
+}
+
+// 根据 slug 取消点赞文章
+func (s *RealworldService) UnfavoriteArticleBySlug(ctx context.Context, req *v1.UnfavoriteArticleBySlugRequest) (*v1.SingleArticlesReply, error) {
+	id, err := s.sc.ResolveSlug(ctx, req.Slug)
+	if err != nil {
+		return nil, err
+	}
+	return s.UnfavoriteArticle(ctx, &v1.UnfavoriteArticleRequest{ArticleId: int64(id)})
+}
+
+// 纯数字的 slug 视为文章ID, 生成 slug 时已避开纯数字
+func isArticleID(slug string) bool {
+	_, err := strconv.Atoi(slug)
+	return err == nil
+}
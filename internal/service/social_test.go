@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	v1 "demo/api/realworld/v1"
+	"demo/internal/biz"
+	"demo/internal/conf"
+	"demo/internal/data"
+	"demo/internal/pkg/middleware/auth"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+)
+
+type headerCarrier http.Header
+
+func (hc headerCarrier) Get(key string) string { return http.Header(hc).Get(key) }
+
+func (hc headerCarrier) Set(key string, value string) { http.Header(hc).Set(key, value) }
+
+func (hc headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(hc))
+	for k := range hc {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+type testTransport struct {
+	reply headerCarrier
+}
+
+func (tr *testTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (tr *testTransport) Endpoint() string                { return "" }
+func (tr *testTransport) Operation() string               { return "" }
+func (tr *testTransport) RequestHeader() transport.Header { return headerCarrier{} }
+func (tr *testTransport) ReplyHeader() transport.Header   { return tr.reply }
+
+func TestGetArticleBySlugRedirect(t *testing.T) {
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Dsn: "file:slugredirect?mode=memory&cache=shared", AutoMigrate: true}}
+	db, err := data.NewDB(c, log.NewStdLogger(os.Stdout))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, _, _ := data.NewData(c, log.DefaultLogger, db)
+	ur := data.NewUserRepo(d, log.DefaultLogger)
+	sc := biz.NewSocialUseCase(data.NewArticleRepo(d, log.DefaultLogger), data.NewCommentRepo(d, log.DefaultLogger), data.NewTagRepo(d, log.DefaultLogger),
+		data.NewFavoriteRepo(d, log.DefaultLogger), ur, data.NewProfileRepo(d, log.DefaultLogger), biz.NewAuthorizer(), d, log.DefaultLogger)
+	s := NewRealworldService(nil, sc, nil, nil, nil, nil, nil, log.DefaultLogger)
+	u := &biz.User{Email: "a@b.c", Username: "a", Role: auth.RoleUser}
+	if err := ur.CreateUser(context.Background(), u); err != nil {
+		t.Fatal(err)
+	}
+	ctx := auth.NewContext(context.Background(), auth.LoginUser{UserID: u.UserID, Username: u.Username, Role: u.Role})
+	ar, err := sc.CreateArticle(ctx, &biz.Article{Title: "Hello", Body: "body"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sc.UpdateArticle(ctx, ar.ID, &biz.Article{Title: "World"}); err != nil {
+		t.Fatal(err)
+	}
+
+	get := func(slug string) (*v1.SingleArticlesReply, *testTransport, error) {
+		tr := &testTransport{reply: headerCarrier{}}
+		reply, err := s.GetArticleBySlug(transport.NewServerContext(context.Background(), tr), &v1.GetArticleBySlugRequest{Slug: slug})
+		return reply, tr, err
+	}
+	// 旧 slug 重定向到当前 slug
+	if _, tr, err := get("hello"); errors.Code(err) != http.StatusMovedPermanently || tr.reply.Get("Location") != "/api/articles/world" {
+		t.Fatalf("expected redirect to new slug, got %v %q", err, tr.reply.Get("Location"))
+	}
+	if reply, _, err := get("world"); err != nil || reply.Article.Slug != "world" {
+		t.Fatalf("expected article by current slug, got %+v %v", reply, err)
+	}
+	// 文章ID直接返回, 兼容旧客户端
+	if reply, _, err := get(strconv.Itoa(ar.ID)); err != nil || reply.Article.Slug != "world" {
+		t.Fatalf("expected article by id without redirect, got %+v %v", reply, err)
+	}
+	// gRPC 请求不重定向
+	reply, err := s.GetArticleBySlug(context.Background(), &v1.GetArticleBySlugRequest{Slug: "hello"})
+	if err != nil || reply.Article.Slug != "world" {
+		t.Fatalf("expected article by old slug over gRPC, got %+v %v", reply, err)
+	}
+	if _, _, err := get("missing"); !errors.IsNotFound(err) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+}
+
+// 旧客户端通过 /api/articles/{article_id} 修改、删除文章, 由 slug 路由按文章ID处理
+func TestArticleByNumericID(t *testing.T) {
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Dsn: "file:numericid?mode=memory&cache=shared", AutoMigrate: true}}
+	db, err := data.NewDB(c, log.NewStdLogger(os.Stdout))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, _, _ := data.NewData(c, log.DefaultLogger, db)
+	ur := data.NewUserRepo(d, log.DefaultLogger)
+	sc := biz.NewSocialUseCase(data.NewArticleRepo(d, log.DefaultLogger), data.NewCommentRepo(d, log.DefaultLogger), data.NewTagRepo(d, log.DefaultLogger),
+		data.NewFavoriteRepo(d, log.DefaultLogger), ur, data.NewProfileRepo(d, log.DefaultLogger), biz.NewAuthorizer(), d, log.DefaultLogger)
+	u := &biz.User{Email: "a@b.c", Username: "a", Role: auth.RoleUser}
+	if err := ur.CreateUser(context.Background(), u); err != nil {
+		t.Fatal(err)
+	}
+	ctx := auth.NewContext(context.Background(), auth.LoginUser{UserID: u.UserID, Username: u.Username, Role: u.Role})
+	ids := []int{}
+	for _, title := range []string{"first", "second"} {
+		ar, err := sc.CreateArticle(ctx, &biz.Article{Title: title, Body: "body"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, ar.ID)
+	}
+	// 标题为另一篇文章的ID时, slug 不会与ID混淆
+	decoy, err := sc.CreateArticle(ctx, &biz.Article{Title: strconv.Itoa(ids[1]), Body: "body"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := khttp.NewServer(khttp.Middleware(func(h middleware.Handler) middleware.Handler {
+		return func(c context.Context, req interface{}) (interface{}, error) {
+			return h(auth.NewContext(c, auth.LoginUser{UserID: u.UserID, Username: u.Username, Role: u.Role}), req)
+		}
+	}))
+	v1.RegisterRealworldHTTPServer(srv, NewRealworldService(nil, sc, nil, nil, nil, nil, nil, log.DefaultLogger))
+	do := func(method, path, body string) int {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		srv.ServeHTTP(rec, req)
+		return rec.Code
+	}
+	path := "/api/articles/" + strconv.Itoa(ids[1])
+
+	if code := do(http.MethodPut, path, `{"article":{"body":"edited"}}`); code != http.StatusOK {
+		t.Fatalf("expected update by id to succeed, got %d", code)
+	}
+	if ar, err := sc.GetArticle(ctx, ids[1]); err != nil || ar.Body != "edited" {
+		t.Fatalf("expected article %d to be updated, got %+v %v", ids[1], ar, err)
+	}
+	for _, id := range []int{ids[0], decoy.ID} {
+		if ar, err := sc.GetArticle(ctx, id); err != nil || ar.Body != "body" {
+			t.Fatalf("expected article %d to be unchanged, got %+v %v", id, ar, err)
+		}
+	}
+
+	if code := do(http.MethodDelete, path, ""); code != http.StatusOK {
+		t.Fatalf("expected delete by id to succeed, got %d", code)
+	}
+	if _, err := sc.GetArticle(ctx, ids[1]); !errors.IsNotFound(err) {
+		t.Fatalf("expected article %d to be deleted, got %v", ids[1], err)
+	}
+	for _, id := range []int{ids[0], decoy.ID} {
+		if _, err := sc.GetArticle(ctx, id); err != nil {
+			t.Fatalf("expected article %d to remain, got %v", id, err)
+		}
+	}
+}
@@ -3,43 +3,48 @@ package service
 import (
 	"context"
 	v1 "demo/api/realworld/v1"
-
-	"github.com/go-kratos/kratos/v2/errors"
+	"demo/internal/biz"
+	"time"
 )
 
 // 登录
 func (s *RealworldService) Login(ctx context.Context, req *v1.LoginRequest) (*v1.UserReply, error) {
-	ud, err := s.uc.Login(ctx, req.User.Email, req.User.Password)
+	ctx = withClient(ctx)
+	ud, err := s.uc.Login(ctx, req.User.Email, req.User.Password, clientIP(ctx))
 	if err != nil {
 		return nil, err
 	}
 	return &v1.UserReply{
 		User: &v1.UserReply_User{
-			UserId:   int64(ud.UserID),
-			Username: ud.Username,
-			Email:    ud.Email,
-			Image:    ud.Image,
-			Token:    ud.Token,
-			Bio:      ud.Bio,
+			UserId:        int64(ud.UserID),
+			Username:      ud.Username,
+			Email:         ud.Email,
+			Image:         ud.Image,
+			Token:         ud.Token,
+			RefreshToken:  ud.RefreshToken,
+			Bio:           ud.Bio,
+			Role:          ud.Role,
+			EmailVerified: ud.EmailVerified,
+			MfaRequired:   ud.MFAToken != "",
+			MfaToken:      ud.MFAToken,
 		},
 	}, nil
 }
 
 // 注册
 func (s *RealworldService) Register(ctx context.Context, req *v1.RegisterRequest) (*v1.UserReply, error) {
-	if req.User.Username == "" || req.User.Email == "" || req.User.Password == "" {
-		return nil, errors.New(422, "params", "error")
-	}
-	u, err := s.uc.Register(ctx, req.User.Username, req.User.Email, req.User.Password)
+	u, err := s.uc.Register(withClient(ctx), req.User.Username, req.User.Email, req.User.Password)
 	if err != nil {
 		return nil, err
 	}
 	return &v1.UserReply{
 		User: &v1.UserReply_User{
-			UserId:   int64(u.UserID),
-			Username: u.Username,
-			Token:    u.Token,
-			Email:    u.Email,
+			UserId:       int64(u.UserID),
+			Username:     u.Username,
+			Token:        u.Token,
+			RefreshToken: u.RefreshToken,
+			Email:        u.Email,
+			Role:         u.Role,
 		},
 	}, nil
 }
@@ -52,12 +57,14 @@ func (s *RealworldService) GetCurrentUser(ctx context.Context, req *v1.GetCurren
 	}
 	return &v1.UserReply{
 		User: &v1.UserReply_User{
-			UserId:   int64(u.UserID),
-			Username: u.Username,
-			Email:    u.Email,
-			Token:    u.Token,
-			Bio:      u.Bio,
-			Image:    u.Image,
+			UserId:        int64(u.UserID),
+			Username:      u.Username,
+			Email:         u.Email,
+			Token:         u.Token,
+			Bio:           u.Bio,
+			Image:         u.Image,
+			Role:          u.Role,
+			EmailVerified: u.EmailVerified,
 		},
 	}, nil
 
@@ -77,10 +84,47 @@ func (s *RealworldService) UpdateUser(ctx context.Context, req *v1.UpdateUserReq
 			Token:    u.Token,
 			Bio:      u.Bio,
 			Image:    u.Image,
+			Role:     u.Role,
 		},
 	}, nil
 }
 
+// 刷新 token
+func (s *RealworldService) RefreshToken(ctx context.Context, req *v1.RefreshTokenRequest) (*v1.UserReply, error) {
+	u, pair, err := s.tu.Refresh(withClient(ctx), req.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.UserReply{
+		User: &v1.UserReply_User{
+			UserId:       int64(u.UserID),
+			Username:     u.Username,
+			Email:        u.Email,
+			Token:        pair.AccessToken,
+			RefreshToken: pair.RefreshToken,
+			Bio:          u.Bio,
+			Image:        u.Image,
+			Role:         u.Role,
+		},
+	}, nil
+}
+
+// 退出登录
+func (s *RealworldService) Logout(ctx context.Context, req *v1.LogoutRequest) (*v1.LogoutReply, error) {
+	if err := s.tu.Logout(ctx, req.RefreshToken); err != nil {
+		return nil, err
+	}
+	return &v1.LogoutReply{}, nil
+}
+
+// 退出所有设备
+func (s *RealworldService) LogoutAll(ctx context.Context, req *v1.LogoutAllRequest) (*v1.LogoutReply, error) {
+	if err := s.tu.LogoutAll(ctx); err != nil {
+		return nil, err
+	}
+	return &v1.LogoutReply{}, nil
+}
+
 // 获取用户简介
 func (s *RealworldService) GetProfile(ctx context.Context, req *v1.GetProfileRequest) (*v1.ProfileReply, error) {
 	p, err := s.uc.GetProfile(ctx, int(req.UserId))
@@ -131,3 +175,248 @@ func (s *RealworldService) UnfollowUser(ctx context.Context, req *v1.UnfollowUse
 		},
 	}, nil
 }
+
+// 修改用户角色
+func (s *RealworldService) SetUserRole(ctx context.Context, req *v1.SetUserRoleRequest) (*v1.UserRoleReply, error) {
+	u, err := s.uc.SetUserRole(ctx, req.Username, req.Role)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.UserRoleReply{
+		UserId:   int64(u.UserID),
+		Username: u.Username,
+		Role:     u.Role,
+	}, nil
+}
+
+// 重新发送邮箱验证邮件
+func (s *RealworldService) RequestEmailVerification(ctx context.Context, req *v1.RequestEmailVerificationRequest) (*v1.EmptyReply, error) {
+	if err := s.au.RequestEmailVerification(ctx, req.Email); err != nil {
+		return nil, err
+	}
+	return &v1.EmptyReply{}, nil
+}
+
+// 验证邮箱
+func (s *RealworldService) ConfirmEmailVerification(ctx context.Context, req *v1.ConfirmEmailVerificationRequest) (*v1.EmptyReply, error) {
+	if err := s.au.ConfirmEmailVerification(ctx, req.Token); err != nil {
+		return nil, err
+	}
+	return &v1.EmptyReply{}, nil
+}
+
+// 发送重置密码邮件
+func (s *RealworldService) RequestPasswordReset(ctx context.Context, req *v1.RequestPasswordResetRequest) (*v1.EmptyReply, error) {
+	if err := s.au.RequestPasswordReset(ctx, req.Email); err != nil {
+		return nil, err
+	}
+	return &v1.EmptyReply{}, nil
+}
+
+// 重置密码
+func (s *RealworldService) ConfirmPasswordReset(ctx context.Context, req *v1.ConfirmPasswordResetRequest) (*v1.EmptyReply, error) {
+	if err := s.au.ConfirmPasswordReset(ctx, req.Token, req.Password); err != nil {
+		return nil, err
+	}
+	return &v1.EmptyReply{}, nil
+}
+
+// 两步登录
+func (s *RealworldService) LoginMFA(ctx context.Context, req *v1.LoginMFARequest) (*v1.UserReply, error) {
+	ctx = withClient(ctx)
+	u, err := s.mu.LoginMFA(ctx, req.MfaToken, req.Code, clientIP(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.UserReply{
+		User: &v1.UserReply_User{
+			UserId:        int64(u.UserID),
+			Username:      u.Username,
+			Email:         u.Email,
+			Image:         u.Image,
+			Token:         u.Token,
+			RefreshToken:  u.RefreshToken,
+			Bio:           u.Bio,
+			Role:          u.Role,
+			EmailVerified: u.EmailVerified,
+		},
+	}, nil
+}
+
+// 绑定 TOTP
+func (s *RealworldService) EnrollTOTP(ctx context.Context, req *v1.EnrollTOTPRequest) (*v1.EnrollTOTPReply, error) {
+	e, err := s.mu.EnrollTOTP(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.EnrollTOTPReply{Secret: e.Secret, ProvisioningUri: e.URI}, nil
+}
+
+// 启用 TOTP
+func (s *RealworldService) ConfirmTOTP(ctx context.Context, req *v1.ConfirmTOTPRequest) (*v1.RecoveryCodesReply, error) {
+	codes, err := s.mu.ConfirmTOTP(ctx, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.RecoveryCodesReply{RecoveryCodes: codes}, nil
+}
+
+// 关闭 TOTP
+func (s *RealworldService) DisableTOTP(ctx context.Context, req *v1.DisableTOTPRequest) (*v1.EmptyReply, error) {
+	if err := s.mu.DisableTOTP(ctx, req.Password, req.Code); err != nil {
+		return nil, err
+	}
+	return &v1.EmptyReply{}, nil
+}
+
+// 解除登录锁定
+func (s *RealworldService) UnlockUser(ctx context.Context, req *v1.UnlockUserRequest) (*v1.EmptyReply, error) {
+	if err := s.uc.UnlockUser(ctx, req.Username); err != nil {
+		return nil, err
+	}
+	return &v1.EmptyReply{}, nil
+}
+
+// 发起第三方登录
+func (s *RealworldService) StartOIDCLogin(ctx context.Context, req *v1.StartOIDCLoginRequest) (*v1.StartOIDCLoginReply, error) {
+	authURL, err := s.ou.StartLogin(ctx, req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.StartOIDCLoginReply{AuthorizationUrl: authURL}, nil
+}
+
+// 第三方登录回调
+func (s *RealworldService) OIDCCallback(ctx context.Context, req *v1.OIDCCallbackRequest) (*v1.UserReply, error) {
+	u, err := s.ou.Callback(withClient(ctx), req.Provider, req.Code, req.State)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.UserReply{
+		User: &v1.UserReply_User{
+			UserId:        int64(u.UserID),
+			Username:      u.Username,
+			Email:         u.Email,
+			Image:         u.Image,
+			Token:         u.Token,
+			RefreshToken:  u.RefreshToken,
+			Bio:           u.Bio,
+			Role:          u.Role,
+			EmailVerified: u.EmailVerified,
+			MfaRequired:   u.MFAToken != "",
+			MfaToken:      u.MFAToken,
+		},
+	}, nil
+}
+
+// 已绑定的第三方身份
+func (s *RealworldService) ListIdentities(ctx context.Context, req *v1.ListIdentitiesRequest) (*v1.ListIdentitiesReply, error) {
+	ids, err := s.ou.ListIdentities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rv := &v1.ListIdentitiesReply{Identities: make([]*v1.ListIdentitiesReply_Identity, 0, len(ids))}
+	for _, id := range ids {
+		rv.Identities = append(rv.Identities, &v1.ListIdentitiesReply_Identity{
+			Provider:  id.Provider,
+			Email:     id.Email,
+			CreatedAt: id.CreatedAt.String(),
+		})
+	}
+	return rv, nil
+}
+
+// 解除第三方身份绑定
+func (s *RealworldService) UnlinkIdentity(ctx context.Context, req *v1.UnlinkIdentityRequest) (*v1.EmptyReply, error) {
+	if err := s.ou.Unlink(ctx, req.Provider); err != nil {
+		return nil, err
+	}
+	return &v1.EmptyReply{}, nil
+}
+
+// 时间为零值时返回空字符串
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.String()
+}
+
+func personalTokenReply(t *biz.PersonalToken) *v1.PersonalToken {
+	return &v1.PersonalToken{
+		Id:         int64(t.ID),
+		Name:       t.Name,
+		Prefix:     t.Prefix,
+		Scopes:     t.Scopes,
+		CreatedAt:  t.CreatedAt.String(),
+		ExpiresAt:  formatTime(t.ExpiresAt),
+		LastUsedAt: formatTime(t.LastUsedAt),
+	}
+}
+
+// 创建个人访问令牌
+func (s *RealworldService) CreatePersonalToken(ctx context.Context, req *v1.CreatePersonalTokenRequest) (*v1.PersonalTokenReply, error) {
+	t, token, err := s.pu.Create(ctx, req.Name, req.Scopes, time.Duration(req.ExpiresInDays)*24*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.PersonalTokenReply{PersonalToken: personalTokenReply(t), Token: token}, nil
+}
+
+// 个人访问令牌列表
+func (s *RealworldService) ListPersonalTokens(ctx context.Context, req *v1.ListPersonalTokensRequest) (*v1.ListPersonalTokensReply, error) {
+	ts, err := s.pu.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rv := &v1.ListPersonalTokensReply{PersonalTokens: make([]*v1.PersonalToken, 0, len(ts))}
+	for _, t := range ts {
+		rv.PersonalTokens = append(rv.PersonalTokens, personalTokenReply(t))
+	}
+	return rv, nil
+}
+
+// 吊销个人访问令牌
+func (s *RealworldService) RevokePersonalToken(ctx context.Context, req *v1.RevokePersonalTokenRequest) (*v1.EmptyReply, error) {
+	if err := s.pu.Revoke(ctx, int(req.Id)); err != nil {
+		return nil, err
+	}
+	return &v1.EmptyReply{}, nil
+}
+
+// 登录会话列表
+func (s *RealworldService) ListSessions(ctx context.Context, req *v1.ListSessionsRequest) (*v1.ListSessionsReply, error) {
+	ss, err := s.tu.ListSessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rv := &v1.ListSessionsReply{Sessions: make([]*v1.Session, 0, len(ss))}
+	for _, se := range ss {
+		rv.Sessions = append(rv.Sessions, &v1.Session{
+			Id:         int64(se.ID),
+			Device:     se.Device,
+			UserAgent:  se.UserAgent,
+			Ip:         se.IP,
+			CreatedAt:  se.CreatedAt.String(),
+			LastSeenAt: formatTime(se.LastSeenAt),
+			Current:    se.Current,
+		})
+	}
+	return rv, nil
+}
+
+// 退出指定会话
+func (s *RealworldService) RevokeSession(ctx context.Context, req *v1.RevokeSessionRequest) (*v1.EmptyReply, error) {
+	if err := s.tu.RevokeSession(ctx, int(req.Id)); err != nil {
+		return nil, err
+	}
+	return &v1.EmptyReply{}, nil
+}
+
+// 退出其他会话
+func (s *RealworldService) RevokeOtherSessions(ctx context.Context, req *v1.RevokeOtherSessionsRequest) (*v1.EmptyReply, error) {
+	if err := s.tu.RevokeOtherSessions(ctx); err != nil {
+		return nil, err
+	}
+	return &v1.EmptyReply{}, nil
+}